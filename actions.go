@@ -0,0 +1,256 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tmshv/ghstars/github"
+)
+
+type ActionDoneMsg struct {
+	status string
+	err    error
+}
+
+type UnstarredMsg struct {
+	ids []int
+	// Repos starred only by teammates
+	skipped int
+	err     error
+}
+
+// action runs against one repo from the action menu
+// or against all marked repos from the bulk menu
+type action struct {
+	title   string
+	confirm bool
+	run     func(m *model, items []repoitem) tea.Cmd
+}
+
+func openAction(title string, url func(repoitem) string) action {
+	return action{
+		title: title,
+		run: func(m *model, items []repoitem) tea.Cmd {
+			return openURLCmd(url(items[0]))
+		},
+	}
+}
+
+func copyAction(title string, text func(repoitem) string) action {
+	return action{
+		title: title,
+		run: func(m *model, items []repoitem) tea.Cmd {
+			return copyCmd(text(items[0]))
+		},
+	}
+}
+
+func openURLCmd(url string) tea.Cmd {
+	return func() tea.Msg {
+		if url == "" {
+			return ActionDoneMsg{err: fmt.Errorf("nothing to open")}
+		}
+		err := OpenURL(url)
+		return ActionDoneMsg{status: fmt.Sprintf("Opened %s", url), err: err}
+	}
+}
+
+func copyCmd(text string) tea.Cmd {
+	return func() tea.Msg {
+		if text == "" {
+			return ActionDoneMsg{err: fmt.Errorf("nothing to copy")}
+		}
+		err := copyToClipboard(text)
+		return ActionDoneMsg{status: fmt.Sprintf("Copied %s", text), err: err}
+	}
+}
+
+func exportCmd(m *model, items []repoitem) tea.Cmd {
+	lists := m.collections.lists
+	return func() tea.Msg {
+		filename := fmt.Sprintf("ghstars-export-%s.json", time.Now().Format("20060102-150405"))
+		err := writeExport(filename, items, lists)
+		return ActionDoneMsg{status: fmt.Sprintf("Exported %d repos to %s", len(items), filename), err: err}
+	}
+}
+
+// unstarCmd unstars items of the authenticated user, team view also
+// lists repos starred only by teammates, they are skipped
+func unstarCmd(m *model, items []repoitem) tea.Cmd {
+	gh := m.gh
+	return func() tea.Msg {
+		var msg UnstarredMsg
+		for _, item := range items {
+			err := gh.Unstar(item.star.Repo.Owner.Login, item.star.Repo.Name)
+			if errors.Is(err, github.ErrNotStarred) {
+				msg.skipped++
+				continue
+			}
+			if err != nil {
+				msg.err = err
+				return msg
+			}
+			msg.ids = append(msg.ids, item.star.Repo.ID)
+		}
+		return msg
+	}
+}
+
+var repoActions = []action{
+	openAction("Open repo", func(i repoitem) string {
+		return i.url
+	}),
+	openAction("Open homepage", func(i repoitem) string {
+		return i.star.Repo.Homepage
+	}),
+	openAction("Open issues", func(i repoitem) string {
+		return i.url + "/issues"
+	}),
+	openAction("Open releases", func(i repoitem) string {
+		return i.url + "/releases"
+	}),
+	copyAction("Copy URL", func(i repoitem) string {
+		return i.url
+	}),
+	copyAction("Copy clone URL", func(i repoitem) string {
+		return i.star.Repo.CloneURL
+	}),
+	copyAction("Copy SSH clone URL", func(i repoitem) string {
+		return i.star.Repo.SSHURL
+	}),
+	copyAction("Copy owner/name", func(i repoitem) string {
+		return i.star.Repo.FullName
+	}),
+	copyAction("Copy Markdown link", markdownLink),
+}
+
+var bulkActions = []action{
+	{
+		title: "Open all",
+		run: func(m *model, items []repoitem) tea.Cmd {
+			cmds := make([]tea.Cmd, 0, len(items))
+			for _, item := range items {
+				cmds = append(cmds, openURLCmd(item.url))
+			}
+			return tea.Batch(cmds...)
+		},
+	},
+	{
+		title: "Copy URLs",
+		run: func(m *model, items []repoitem) tea.Cmd {
+			urls := make([]string, 0, len(items))
+			for _, item := range items {
+				urls = append(urls, item.url)
+			}
+			return copyCmd(strings.Join(urls, "\n"))
+		},
+	},
+	{
+		title: "Export selection",
+		run: func(m *model, items []repoitem) tea.Cmd {
+			return exportCmd(m, items)
+		},
+	},
+	{
+		title: "Add tag",
+		run: func(m *model, items []repoitem) tea.Cmd {
+			return m.prompt.open("Add tag", "", func(m *model, tag string) tea.Cmd {
+				return m.addTag(items, tag)
+			})
+		},
+	},
+	unstarAction,
+}
+
+var unstarAction = action{
+	title:   "Unstar",
+	confirm: true,
+	run:     unstarCmd,
+}
+
+func markdownLink(i repoitem) string {
+	return fmt.Sprintf("[%s](%s)", i.star.Repo.FullName, i.url)
+}
+
+type actionKeyMap struct {
+	up     key.Binding
+	down   key.Binding
+	choose key.Binding
+	close  key.Binding
+}
+
+func newActionKeyMap() *actionKeyMap {
+	return &actionKeyMap{
+		up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		choose: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "run action"),
+		),
+		close: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc", "close menu"),
+		),
+	}
+}
+
+type actionMenu struct {
+	visible bool
+	title   string
+	items   []repoitem
+	actions []action
+	cursor  int
+	keys    *actionKeyMap
+}
+
+func newActionMenu(keys *actionKeyMap) actionMenu {
+	return actionMenu{
+		keys: keys,
+	}
+}
+
+func (a *actionMenu) open(title string, items []repoitem, actions []action) {
+	a.visible = true
+	a.title = title
+	a.items = items
+	a.actions = actions
+	a.cursor = 0
+}
+
+// update handles key presses while menu is open and returns chosen action
+func (a *actionMenu) update(msg tea.KeyMsg) *action {
+	switch {
+	case key.Matches(msg, a.keys.up):
+		a.cursor = max(a.cursor-1, 0)
+	case key.Matches(msg, a.keys.down):
+		a.cursor = min(a.cursor+1, len(a.actions)-1)
+	case key.Matches(msg, a.keys.close):
+		a.visible = false
+	case key.Matches(msg, a.keys.choose):
+		a.visible = false
+		return &a.actions[a.cursor]
+	}
+	return nil
+}
+
+func (a *actionMenu) View() string {
+	lines := []string{a.title, ""}
+	for i, act := range a.actions {
+		if i == a.cursor {
+			lines = append(lines, actionCursorStyle.Render("> "+act.title))
+		} else {
+			lines = append(lines, "  "+act.title)
+		}
+	}
+	return actionMenuStyle.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/tmshv/ghstars/github"
+)
+
+func TestUnstarSkipsTeammateStars(t *testing.T) {
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "DELETE":
+			deleted = append(deleted, r.URL.Path)
+		case r.URL.Query().Get("page") == "1":
+			w.Write([]byte(`[{"starred_at": "2020-01-02T00:00:00Z", "repo": {"id": 1, "full_name": "a/mine"}}]`))
+		default:
+			w.Write([]byte("[]"))
+		}
+	}))
+	defer srv.Close()
+	gh := github.New("token")
+	gh.SetAPIURL(srv.URL)
+
+	item := func(id int, owner, name string) repoitem {
+		var star github.GhStarV3
+		star.Repo.ID = id
+		star.Repo.Owner.Login = owner
+		star.Repo.Name = name
+		star.Repo.FullName = owner + "/" + name
+		return repoitem{star: &star}
+	}
+	items := []repoitem{item(1, "a", "mine"), item(2, "b", "theirs")}
+
+	msg := unstarCmd(&model{gh: gh}, items)().(UnstarredMsg)
+	if msg.err != nil {
+		t.Fatal(msg.err)
+	}
+	if !slices.Equal(msg.ids, []int{1}) || msg.skipped != 1 {
+		t.Errorf("unstarred %v, skipped %d, want [1], 1", msg.ids, msg.skipped)
+	}
+	if !slices.Equal(deleted, []string{"/user/starred/a/mine"}) {
+		t.Errorf("deleted %v, want only own star", deleted)
+	}
+}
@@ -0,0 +1,112 @@
+package annotations
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"slices"
+)
+
+type Annotation struct {
+	Repo string   `json:"repo"`
+	Tags []string `json:"tags,omitempty"`
+	Note string   `json:"note,omitempty"`
+}
+
+// Store keeps personal annotations of repos keyed by repo ID.
+// It lives apart from star cache so refreshing stars keeps annotations.
+type Store struct {
+	filename string
+	items    map[int]*Annotation
+}
+
+func Load(filename string) (*Store, error) {
+	s := &Store{
+		filename: filename,
+		items:    map[int]*Annotation{},
+	}
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &s.items)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) Save() error {
+	data, err := json.MarshalIndent(s.items, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.filename, data, 0644)
+}
+
+func (s *Store) get(id int, repo string) *Annotation {
+	a, ok := s.items[id]
+	if !ok {
+		a = &Annotation{}
+		s.items[id] = a
+	}
+	a.Repo = repo
+	return a
+}
+
+// cleanup drops annotation without any data
+func (s *Store) cleanup(id int) {
+	if a, ok := s.items[id]; ok && len(a.Tags) == 0 && a.Note == "" {
+		delete(s.items, id)
+	}
+}
+
+// Get returns annotation of repo or nil
+func (s *Store) Get(id int) *Annotation {
+	return s.items[id]
+}
+
+// All returns annotations keyed by repo ID
+func (s *Store) All() map[int]*Annotation {
+	return s.items
+}
+
+func (s *Store) Tags(id int) []string {
+	if a, ok := s.items[id]; ok {
+		return a.Tags
+	}
+	return nil
+}
+
+func (s *Store) Note(id int) string {
+	if a, ok := s.items[id]; ok {
+		return a.Note
+	}
+	return ""
+}
+
+func (s *Store) AddTag(id int, repo, tag string) {
+	a := s.get(id, repo)
+	if !slices.Contains(a.Tags, tag) {
+		a.Tags = append(a.Tags, tag)
+	}
+}
+
+func (s *Store) RemoveTag(id int, tag string) {
+	a, ok := s.items[id]
+	if !ok {
+		return
+	}
+	a.Tags = slices.DeleteFunc(a.Tags, func(t string) bool {
+		return t == tag
+	})
+	s.cleanup(id)
+}
+
+func (s *Store) SetNote(id int, repo, note string) {
+	s.get(id, repo).Note = note
+	s.cleanup(id)
+}
@@ -0,0 +1,87 @@
+package annotations
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestAddTag(t *testing.T) {
+	s, err := Load(filepath.Join(t.TempDir(), "notes.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.AddTag(1, "a/a", "go")
+	s.AddTag(1, "a/a", "cli")
+	s.AddTag(1, "a/a", "go")
+
+	if tags := s.Tags(1); !reflect.DeepEqual(tags, []string{"go", "cli"}) {
+		t.Errorf("Tags(1) = %v, want [go cli]", tags)
+	}
+	if a := s.Get(1); a == nil || a.Repo != "a/a" {
+		t.Errorf("Get(1) = %+v, want repo a/a", a)
+	}
+}
+
+func TestRemoveTag(t *testing.T) {
+	tests := []struct {
+		name string
+		note string
+		kept bool
+	}{
+		{"keeps note", "read later", true},
+		{"drops empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := Load(filepath.Join(t.TempDir(), "notes.json"))
+			s.AddTag(1, "a/a", "go")
+			s.SetNote(1, "a/a", tt.note)
+			s.RemoveTag(1, "go")
+			s.RemoveTag(2, "go")
+
+			a := s.Get(1)
+			if (a != nil) != tt.kept {
+				t.Fatalf("Get(1) = %+v, want kept %v", a, tt.kept)
+			}
+			if a != nil && (len(a.Tags) != 0 || a.Note != tt.note) {
+				t.Errorf("Get(1) = %+v, want no tags and note %q", a, tt.note)
+			}
+			if s.Get(2) != nil {
+				t.Errorf("RemoveTag added annotation of repo 2")
+			}
+		})
+	}
+}
+
+func TestSetNote(t *testing.T) {
+	s, _ := Load(filepath.Join(t.TempDir(), "notes.json"))
+	s.SetNote(1, "a/a", "read later")
+	s.SetNote(1, "a/a", "")
+
+	if len(s.All()) != 0 {
+		t.Errorf("All() = %v, want empty annotation dropped", s.All())
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "notes.json")
+	s, err := Load(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.AddTag(1, "a/a", "go")
+	s.SetNote(2, "b/b", "read later")
+	if err := s.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loaded.All(), s.All()) {
+		t.Errorf("loaded %v, want %v", loaded.All(), s.All())
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+var errNoBrowser = errors.New("no browser launcher found")
+
+// browserCommand picks a launcher for url. $BROWSER is honoured first,
+// it may hold several commands separated by colons and use %s for url.
+func browserCommand(url string) (*exec.Cmd, error) {
+	for _, browser := range strings.Split(os.Getenv("BROWSER"), string(os.PathListSeparator)) {
+		fields := strings.Fields(browser)
+		if len(fields) == 0 {
+			continue
+		}
+		if _, err := exec.LookPath(fields[0]); err != nil {
+			continue
+		}
+		args := fields[1:]
+		replaced := false
+		for i, arg := range args {
+			if strings.Contains(arg, "%s") {
+				args[i] = strings.ReplaceAll(arg, "%s", url)
+				replaced = true
+			}
+		}
+		if !replaced {
+			args = append(args, url)
+		}
+		return exec.Command(fields[0], args...), nil
+	}
+
+	var launchers []string
+	switch runtime.GOOS {
+	case "darwin":
+		launchers = []string{"open"}
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url), nil
+	default:
+		if isWSL() {
+			launchers = append(launchers, "wslview")
+		}
+		launchers = append(launchers, "xdg-open", "open")
+	}
+	for _, launcher := range launchers {
+		if _, err := exec.LookPath(launcher); err == nil {
+			return exec.Command(launcher, url), nil
+		}
+	}
+	return nil, errNoBrowser
+}
+
+func isWSL() bool {
+	data, err := os.ReadFile("/proc/version")
+	if err != nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(string(data)), "microsoft")
+}
+
+func OpenURL(url string) error {
+	cmd, err := browserCommand(url)
+	if err != nil {
+		return err
+	}
+	err = cmd.Start()
+	if err != nil {
+		return err
+	}
+
+	// Wait for the command to finish executing
+	err = cmd.Wait()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tmshv/ghstars/github"
+	"github.com/tmshv/ghstars/set"
+)
+
+// collections switch the TUI between all stars and a single star list
+type collections struct {
+	lists   []github.StarList
+	current int
+	members *set.Set[int]
+}
+
+func newCollections() collections {
+	return collections{current: -1}
+}
+
+func (c *collections) set(lists []github.StarList) {
+	c.lists = lists
+	c.selectIndex(-1)
+}
+
+func (c *collections) selectIndex(i int) {
+	c.current = i
+	c.members = nil
+	if i < 0 || i >= len(c.lists) {
+		c.current = -1
+		return
+	}
+	c.members = set.New[int]()
+	for _, id := range c.lists[i].Repos {
+		c.members.Add(id)
+	}
+}
+
+// selectName picks list by name or slug, it reports whether list exists
+func (c *collections) selectName(name string) bool {
+	for i, list := range c.lists {
+		if strings.EqualFold(list.Name, name) || strings.EqualFold(list.Slug, name) {
+			c.selectIndex(i)
+			return true
+		}
+	}
+	return false
+}
+
+// next cycles through all stars and every list
+func (c *collections) next() {
+	i := c.current + 1
+	if i >= len(c.lists) {
+		i = -1
+	}
+	c.selectIndex(i)
+}
+
+func (c *collections) match(item repoitem) bool {
+	if c.members == nil {
+		return true
+	}
+	return c.members.Has(item.star.Repo.ID)
+}
+
+func (c *collections) title(username string) string {
+	title := fmt.Sprintf("%s's Stars", username)
+	if c.current >= 0 {
+		title += " / " + c.lists[c.current].Name
+	}
+	return title
+}
+
+// listNames maps repo ID to names of lists containing it
+func listNames(lists []github.StarList) map[int][]string {
+	names := map[int][]string{}
+	for _, list := range lists {
+		for _, id := range list.Repos {
+			names[id] = append(names[id], list.Name)
+		}
+	}
+	return names
+}
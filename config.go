@@ -0,0 +1,519 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/icons"
+	"github.com/tmshv/ghstars/theme"
+	"gopkg.in/yaml.v3"
+)
+
+// Config files looked up in config dir, first found is used
+var configNames = []string{"config.toml", "config.yaml", "config.yml"}
+
+type filters struct {
+	// Show archived repos
+	Archived  bool     `yaml:"archived,omitempty" toml:"archived,omitempty"`
+	List      string   `yaml:"list,omitempty" toml:"list,omitempty"`
+	Languages []string `yaml:"languages,omitempty" toml:"languages,omitempty"`
+	Topics    []string `yaml:"topics,omitempty" toml:"topics,omitempty"`
+	Licenses  []string `yaml:"licenses,omitempty" toml:"licenses,omitempty"`
+	// How facets of the same kind are combined: or, and
+	Mode string `yaml:"mode,omitempty" toml:"mode,omitempty"`
+}
+
+type config struct {
+	// Comma separated GitHub usernames
+	Username string `yaml:"username,omitempty" toml:"username,omitempty"`
+	// Where token comes from: env, gh, file:PATH or command:CMD
+	Token string `yaml:"token,omitempty" toml:"token,omitempty"`
+	// Cache policy: never, always or max age like 24h
+	Cache   string                 `yaml:"cache,omitempty" toml:"cache,omitempty"`
+	APIURL  string                 `yaml:"api_url,omitempty" toml:"api_url,omitempty"`
+	Icons   string                 `yaml:"icons,omitempty" toml:"icons,omitempty"`
+	Theme   string                 `yaml:"theme,omitempty" toml:"theme,omitempty"`
+	Sort    string                 `yaml:"sort,omitempty" toml:"sort,omitempty"`
+	Keymap  string                 `yaml:"keymap,omitempty" toml:"keymap,omitempty"`
+	Filters filters                `yaml:"filters,omitempty" toml:"filters,omitempty"`
+	Themes  map[string]theme.Theme `yaml:"themes,omitempty" toml:"themes,omitempty"`
+	// Keys of actions by name on top of keymap preset
+	Keys map[string][]string `yaml:"keys,omitempty" toml:"keys,omitempty"`
+}
+
+// Config of current run, CLI flags take precedence over it
+var conf = &config{}
+
+// configDir follows XDG base directory spec on every platform
+func configDir() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "ghstars"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "ghstars"), nil
+}
+
+// configPath returns existing config file or path new one is created at
+func configPath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	for _, name := range configNames {
+		filename := filepath.Join(dir, name)
+		if _, err := os.Stat(filename); err == nil {
+			return filename, nil
+		}
+	}
+	return filepath.Join(dir, configNames[0]), nil
+}
+
+func isTOML(filename string) bool {
+	return filepath.Ext(filename) == ".toml"
+}
+
+// decodeConfig decodes data in format of filename into out rejecting unknown keys
+func decodeConfig(filename string, data []byte, out any) error {
+	if isTOML(filename) {
+		meta, err := toml.Decode(string(data), out)
+		if err != nil {
+			return err
+		}
+		if keys := meta.Undecoded(); len(keys) > 0 {
+			return fmt.Errorf("unknown key %q", keys[0].String())
+		}
+		return nil
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	err := dec.Decode(out)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+func encodeConfig(filename string, val any) ([]byte, error) {
+	if isTOML(filename) {
+		var b bytes.Buffer
+		err := toml.NewEncoder(&b).Encode(val)
+		return b.Bytes(), err
+	}
+	return yaml.Marshal(val)
+}
+
+// loadConfig reads config file, missing file gives empty config
+func loadConfig() (*config, error) {
+	cfg := &config{}
+	filename, err := configPath()
+	if err != nil {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = decodeConfig(filename, data, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("config %s: %w", filename, err)
+	}
+	err = cfg.validate()
+	if err != nil {
+		return nil, fmt.Errorf("config %s: %w", filename, err)
+	}
+	return cfg, nil
+}
+
+func (c *config) validate() error {
+	_, _, err := parseCachePolicy(c.Cache)
+	if err != nil {
+		return err
+	}
+	_, err = parseSort(c.Sort)
+	if err != nil {
+		return err
+	}
+	if c.Icons != "" {
+		_, err = icons.Get(c.Icons)
+		if err != nil {
+			return err
+		}
+	}
+	if c.Theme != "" && c.Theme != "auto" {
+		_, err = theme.Get(c.Theme, c.Themes)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = loadKeyMap(c.Keymap, c.Keys)
+	if err != nil {
+		return err
+	}
+	switch c.Filters.Mode {
+	case "", "or", "and":
+	default:
+		return fmt.Errorf("unknown filters mode %q, expected or, and", c.Filters.Mode)
+	}
+	if c.Token != "" && c.Token != "env" && c.Token != "gh" &&
+		!strings.HasPrefix(c.Token, "file:") && !strings.HasPrefix(c.Token, "command:") {
+		return fmt.Errorf("unknown token source %q, expected env, gh, file:PATH or command:CMD", c.Token)
+	}
+	return nil
+}
+
+// parseCachePolicy returns whether cache is used and max age of cached files
+func parseCachePolicy(val string) (bool, time.Duration, error) {
+	switch val {
+	case "", "never":
+		return false, 0, nil
+	case "always":
+		return true, 0, nil
+	}
+	ttl, err := time.ParseDuration(val)
+	if err != nil || ttl <= 0 {
+		return false, 0, fmt.Errorf("unknown cache policy %q, expected never, always or max age like 24h", val)
+	}
+	return true, ttl, nil
+}
+
+// readToken gets GitHub token from source set in config
+func readToken(source string) (string, error) {
+	switch {
+	case source == "" || source == "env":
+		err := godotenv.Load()
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("load .env: %w", err)
+		}
+		return os.Getenv("GITHUB_TOKEN"), nil
+	case source == "gh":
+		out, err := exec.Command("gh", "auth", "token").Output()
+		if err != nil {
+			return "", fmt.Errorf("gh auth token: %w", err)
+		}
+		return strings.TrimSpace(string(out)), nil
+	case strings.HasPrefix(source, "file:"):
+		data, err := os.ReadFile(strings.TrimPrefix(source, "file:"))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	case strings.HasPrefix(source, "command:"):
+		out, err := exec.Command("sh", "-c", strings.TrimPrefix(source, "command:")).Output()
+		if err != nil {
+			return "", fmt.Errorf("token command: %w", err)
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+	return "", fmt.Errorf("unknown token source %q", source)
+}
+
+// applyConfig fills settings not given on command line from config
+func applyConfig(cmd *cobra.Command, cfg *config) error {
+	conf = cfg
+	flags := cmd.Flags()
+	if !flags.Changed("username") && teamFile == "" && cfg.Username != "" {
+		usernames = strings.Split(cfg.Username, ",")
+	}
+	use, ttl, err := parseCachePolicy(cfg.Cache)
+	if err != nil {
+		return err
+	}
+	if !flags.Changed("cache") {
+		useCache = use
+		cacheTTL = ttl
+	}
+	if flags.Lookup("icons") != nil && !flags.Changed("icons") && cfg.Icons != "" {
+		iconName = cfg.Icons
+	}
+	if flags.Lookup("theme") != nil && !flags.Changed("theme") {
+		themeName = cfg.Theme
+	}
+	if flags.Lookup("sort") != nil && !flags.Changed("sort") && cfg.Sort != "" {
+		sortName = cfg.Sort
+	}
+	if flags.Lookup("keymap") != nil && !flags.Changed("keymap") {
+		keymapName = cfg.Keymap
+	}
+	if flags.Lookup("list") != nil && !flags.Changed("list") {
+		listName = cfg.Filters.List
+	}
+	return nil
+}
+
+// applyFilters selects default filters of config
+func (m *model) applyFilters(f filters) {
+	m.showArchived = f.Archived
+	if f.Mode == "and" {
+		m.facets.mode = facetAnd
+	}
+	add := func(kind facetKind, values []string) {
+		for _, val := range values {
+			m.facets.selected = append(m.facets.selected, facet{kind: kind, value: val})
+		}
+	}
+	add(facetLanguage, f.Languages)
+	add(facetTopic, f.Topics)
+	add(facetLicense, f.Licenses)
+}
+
+// configKey returns type of dotted key in config, themes take any name
+func configKey(key string) (reflect.Type, bool) {
+	t := reflect.TypeOf(config{})
+	for _, part := range strings.Split(key, ".") {
+		switch t.Kind() {
+		case reflect.Struct:
+			var found bool
+			for i := 0; i < t.NumField(); i++ {
+				tag, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+				if tag == part {
+					t = t.Field(i).Type
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, false
+			}
+		case reflect.Map:
+			t = t.Elem()
+		default:
+			return nil, false
+		}
+	}
+	return t, true
+}
+
+func getKey(values map[string]any, key string) (any, bool) {
+	var val any = values
+	for _, part := range strings.Split(key, ".") {
+		m, ok := val.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		val, ok = m[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return val, true
+}
+
+func setKey(values map[string]any, key string, val any) {
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := values[part].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			values[part] = next
+		}
+		values = next
+	}
+	values[parts[len(parts)-1]] = val
+}
+
+// parseValue turns command line value into type of key,
+// lists are given comma separated
+func parseValue(t reflect.Type, val string) (any, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return val, nil
+	case reflect.Bool:
+		switch val {
+		case "true", "yes", "on":
+			return true, nil
+		case "false", "no", "off":
+			return false, nil
+		}
+		return nil, fmt.Errorf("expected true or false, got %q", val)
+	case reflect.Slice:
+		if val == "" {
+			return []any{}, nil
+		}
+		var items []any
+		for _, item := range strings.Split(val, ",") {
+			items = append(items, strings.TrimSpace(item))
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("set it with config edit")
+}
+
+func readConfigValues(filename string) (map[string]any, error) {
+	values := map[string]any{}
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if isTOML(filename) {
+		_, err = toml.Decode(string(data), &values)
+	} else {
+		err = yaml.Unmarshal(data, &values)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config %s: %w", filename, err)
+	}
+	if values == nil {
+		values = map[string]any{}
+	}
+	return values, nil
+}
+
+func printValue(val any) error {
+	switch val := val.(type) {
+	case map[string]any, []any:
+		data, err := yaml.Marshal(val)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(data))
+	default:
+		fmt.Println(val)
+	}
+	return nil
+}
+
+func configCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Show and change config file",
+		// Broken config must not prevent fixing it
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "path",
+		Short: "Print path of config file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filename, err := configPath()
+			if err != nil {
+				return err
+			}
+			fmt.Println(filename)
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "get [key]",
+		Short: "Print value of dotted key like filters.languages, whole config without key",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filename, err := configPath()
+			if err != nil {
+				return err
+			}
+			values, err := readConfigValues(filename)
+			if err != nil {
+				return err
+			}
+			if len(args) == 0 {
+				return printValue(values)
+			}
+			if _, ok := configKey(args[0]); !ok {
+				return fmt.Errorf("unknown key %q", args[0])
+			}
+			val, ok := getKey(values, args[0])
+			if !ok {
+				return nil
+			}
+			return printValue(val)
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "set <key> <value>",
+		Short: "Set value of dotted key, lists are comma separated",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			t, ok := configKey(key)
+			if !ok {
+				return fmt.Errorf("unknown key %q", key)
+			}
+			val, err := parseValue(t, args[1])
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			filename, err := configPath()
+			if err != nil {
+				return err
+			}
+			values, err := readConfigValues(filename)
+			if err != nil {
+				return err
+			}
+			setKey(values, key, val)
+
+			data, err := encodeConfig(filename, values)
+			if err != nil {
+				return err
+			}
+			cfg := &config{}
+			err = decodeConfig(filename, data, cfg)
+			if err == nil {
+				err = cfg.validate()
+			}
+			if err != nil {
+				return err
+			}
+			err = os.MkdirAll(filepath.Dir(filename), 0755)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(filename, data, 0644)
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "edit",
+		Short: "Open config file in $VISUAL or $EDITOR",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filename, err := configPath()
+			if err != nil {
+				return err
+			}
+			err = os.MkdirAll(filepath.Dir(filename), 0755)
+			if err != nil {
+				return err
+			}
+			editor := cmp.Or(os.Getenv("VISUAL"), os.Getenv("EDITOR"), "vi")
+			// Editor may come with arguments, e.g. "code --wait"
+			c := exec.Command("sh", "-c", editor+` "$1"`, "sh", filename)
+			c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+			err = c.Run()
+			if err != nil {
+				return err
+			}
+			_, err = loadConfig()
+			return err
+		},
+	})
+
+	return cmd
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseCachePolicy(t *testing.T) {
+	tests := []struct {
+		val     string
+		use     bool
+		ttl     time.Duration
+		wantErr bool
+	}{
+		{"", false, 0, false},
+		{"never", false, 0, false},
+		{"always", true, 0, false},
+		{"24h", true, 24 * time.Hour, false},
+		{"-1h", false, 0, true},
+		{"sometimes", false, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.val, func(t *testing.T) {
+			use, ttl, err := parseCachePolicy(tt.val)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCachePolicy(%q) error = %v, wantErr %v", tt.val, err, tt.wantErr)
+			}
+			if use != tt.use || ttl != tt.ttl {
+				t.Errorf("parseCachePolicy(%q) = %v, %v, want %v, %v", tt.val, use, ttl, tt.use, tt.ttl)
+			}
+		})
+	}
+}
+
+func TestConfigKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		kind reflect.Kind
+		ok   bool
+	}{
+		{"username", reflect.String, true},
+		{"filters.archived", reflect.Bool, true},
+		{"filters.languages", reflect.Slice, true},
+		{"themes.mine.primary", reflect.String, true},
+		{"filters", reflect.Struct, true},
+		{"colour", reflect.Invalid, false},
+		{"username.first", reflect.Invalid, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			typ, ok := configKey(tt.key)
+			if ok != tt.ok {
+				t.Fatalf("configKey(%q) ok = %v, want %v", tt.key, ok, tt.ok)
+			}
+			if ok && typ.Kind() != tt.kind {
+				t.Errorf("configKey(%q) = %v, want %v", tt.key, typ.Kind(), tt.kind)
+			}
+		})
+	}
+}
+
+func TestSetKey(t *testing.T) {
+	values := map[string]any{"username": "octocat"}
+	setKey(values, "filters.languages", []any{"Go"})
+	setKey(values, "filters.archived", true)
+
+	if val, ok := getKey(values, "filters.languages"); !ok || !reflect.DeepEqual(val, []any{"Go"}) {
+		t.Errorf("getKey(filters.languages) = %v, %v", val, ok)
+	}
+	if val, ok := getKey(values, "filters.archived"); !ok || val != true {
+		t.Errorf("getKey(filters.archived) = %v, %v", val, ok)
+	}
+	if _, ok := getKey(values, "username.first"); ok {
+		t.Errorf("getKey(username.first) found value in string")
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		key      string
+		val      string
+		expected any
+		wantErr  bool
+	}{
+		{"username", "octocat", "octocat", false},
+		{"filters.archived", "yes", true, false},
+		{"filters.archived", "maybe", nil, true},
+		{"filters.topics", "cli, tui", []any{"cli", "tui"}, false},
+		{"filters.topics", "", []any{}, false},
+		{"filters", "x", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.val, func(t *testing.T) {
+			typ, _ := configKey(tt.key)
+			got, err := parseValue(typ, tt.val)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseValue(%q) error = %v, wantErr %v", tt.val, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseValue(%q) = %#v, want %#v", tt.val, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDecodeConfig(t *testing.T) {
+	tests := []struct {
+		filename string
+		data     string
+		expected config
+		wantErr  bool
+	}{
+		{"config.toml", "username = \"octocat\"\n[filters]\nlanguages = [\"Go\"]\n", config{Username: "octocat", Filters: filters{Languages: []string{"Go"}}}, false},
+		{"config.yaml", "username: octocat\nfilters:\n  languages: [Go]\n", config{Username: "octocat", Filters: filters{Languages: []string{"Go"}}}, false},
+		{"config.toml", "keymap = \"vim\"\n[keys]\nunstar = [\"D\"]\n", config{Keymap: "vim", Keys: map[string][]string{"unstar": {"D"}}}, false},
+		{"config.yaml", "", config{}, false},
+		{"config.toml", "colour = \"red\"\n", config{}, true},
+		{"config.yml", "colour: red\n", config{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			var cfg config
+			err := decodeConfig(tt.filename, []byte(tt.data), &cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(cfg, tt.expected) {
+				t.Errorf("decodeConfig() = %+v, want %+v", cfg, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	ansi "github.com/muesli/reflow/truncate"
+	"github.com/tmshv/ghstars/github"
+	"github.com/tmshv/ghstars/icons"
+)
+
+const (
+	// Name column never gets narrower, other columns are hidden instead
+	nameMinWidth   = 20
+	nameMaxWidth   = 48
+	topicsMinWidth = 12
+	teamWidth      = 16
+)
+
+// repoDelegate renders repos as aligned columns: icon, owner/name with badges,
+// stars, pushed and starred age and topics. Description goes on the second
+// line unless compact.
+type repoDelegate struct {
+	sel       *selection
+	compact   bool
+	team      int
+	iconWidth int
+	styles    list.DefaultItemStyles
+}
+
+func newRepoDelegate(sel *selection, compact bool, team int) repoDelegate {
+	var iconWidth int
+	for _, lang := range []string{"Go", "Python", "TypeScript", "Jupyter Notebook"} {
+		iconWidth = max(iconWidth, lipgloss.Width(iconSet.Lang(lang)))
+	}
+	return repoDelegate{
+		sel:       sel,
+		compact:   compact,
+		team:      team,
+		iconWidth: iconWidth,
+		styles:    itemStyles,
+	}
+}
+
+func (d repoDelegate) Height() int {
+	if d.compact {
+		return 1
+	}
+	return 2
+}
+
+func (d repoDelegate) Spacing() int {
+	if d.compact {
+		return 0
+	}
+	return 1
+}
+
+func (d repoDelegate) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+// repoBadges returns icons of repo states, license and known topics
+func repoBadges(star *github.GhStarV3) []string {
+	repo := star.Repo
+	var badges []string
+	add := func(badge string) {
+		if badge != "" && !slices.Contains(badges, badge) {
+			badges = append(badges, badge)
+		}
+	}
+	mirror, _ := repo.MirrorURL.(string)
+	states := []struct {
+		on    bool
+		state icons.State
+	}{
+		{repo.Archived, icons.Archived},
+		{repo.Fork, icons.Fork},
+		{repo.IsTemplate, icons.Template},
+		{repo.Disabled, icons.Disabled},
+		{mirror != "", icons.Mirror},
+	}
+	for _, s := range states {
+		if s.on {
+			// States stay visible with icon sets that have none
+			add(cmp.Or(iconSet.State(s.state), "["+s.state.String()+"]"))
+		}
+	}
+	add(iconSet.License(repo.License.SpdxID))
+	for _, topic := range repo.Topics {
+		add(iconSet.Topic(topic))
+	}
+	return badges
+}
+
+func formatCount(n int) string {
+	var s string
+	// Unit is chosen after rounding, so 999950 shows as 1M, not 1000k
+	switch k := math.Round(float64(n)/100) / 10; {
+	case n < 1000:
+		return fmt.Sprintf("%d", n)
+	case k < 1000:
+		s = fmt.Sprintf("%.1fk", k)
+	default:
+		s = fmt.Sprintf("%.1fM", float64(n)/1000000)
+	}
+	return strings.Replace(s, ".0", "", 1)
+}
+
+func formatAge(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	days := int(time.Since(t).Hours() / 24)
+	switch {
+	case days < 1:
+		return "today"
+	case days < 14:
+		return fmt.Sprintf("%dd", days)
+	case days < 60:
+		return fmt.Sprintf("%dw", days/7)
+	case days < 365:
+		return fmt.Sprintf("%dmo", days/30)
+	}
+	return fmt.Sprintf("%dy", days/365)
+}
+
+// fieldMatches returns matched runes of text found in field n of filter value,
+// relative to start of text
+func fieldMatches(fields []string, n int, text string, matches []int) []int {
+	if len(matches) == 0 || n >= len(fields) {
+		return nil
+	}
+	pos := strings.Index(fields[n], text)
+	if pos < 0 {
+		return nil
+	}
+	start := utf8.RuneCountInString(fields[n][:pos])
+	for _, field := range fields[:n] {
+		start += utf8.RuneCountInString(field) + 1
+	}
+	end := start + utf8.RuneCountInString(text)
+	var res []int
+	for _, m := range matches {
+		if m >= start && m < end {
+			res = append(res, m-start)
+		}
+	}
+	return res
+}
+
+func highlight(text string, matches []int, style, match lipgloss.Style) string {
+	if len(matches) == 0 {
+		return style.Render(text)
+	}
+	return lipgloss.StyleRunes(text, matches, match, style)
+}
+
+// fit truncates or pads text to exactly width cells
+func fit(text string, width int, right bool) string {
+	// reflow keeps room for tail even when text fits
+	if lipgloss.Width(text) > width {
+		text = ansi.StringWithTail(text, uint(max(width, 0)), "…")
+	}
+	pad := strings.Repeat(" ", max(width-lipgloss.Width(text), 0))
+	if right {
+		return pad + text
+	}
+	return text + pad
+}
+
+type column struct {
+	text  string
+	width int
+	right bool
+}
+
+func (d repoDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	i, ok := item.(repoitem)
+	if !ok || m.Width() <= 0 {
+		return
+	}
+	repo := i.star.Repo
+	s := &d.styles
+
+	var (
+		isSelected  = index == m.Index()
+		emptyFilter = m.FilterState() == list.Filtering && m.FilterValue() == ""
+		isFiltered  = m.FilterState() == list.Filtering || m.FilterState() == list.FilterApplied
+	)
+	var matches []int
+	if isFiltered && index < len(m.VisibleItems()) {
+		matches = m.MatchesForItem(index)
+	}
+
+	title, desc := s.NormalTitle, s.NormalDesc
+	switch {
+	case emptyFilter:
+		title, desc = s.DimmedTitle, s.DimmedDesc
+	case isSelected && m.FilterState() != list.Filtering:
+		title, desc = s.SelectedTitle, s.SelectedDesc
+	}
+	row := title.Copy().UnsetForeground()
+	title = title.Copy().Inline(true)
+	desc = desc.Copy().Inline(true)
+	titleMatch := title.Copy().Inherit(s.FilterMatch)
+	descMatch := desc.Copy().Inherit(s.FilterMatch)
+	width := m.Width() - row.GetHorizontalFrameSize()
+	fields := i.filterFields()
+
+	var prefix []string
+	if d.sel.marked.Len() > 0 || d.sel.visual {
+		mark := "  "
+		if d.sel.isMarked(i, index, m.Index()) {
+			mark = "✓ "
+		}
+		prefix = append(prefix, title.Render(mark))
+	}
+	if d.iconWidth > 0 {
+		prefix = append(prefix, fit(iconSet.Lang(repo.Language), d.iconWidth, false)+" ")
+	}
+	indent := lipgloss.Width(strings.Join(prefix, ""))
+
+	optional := []column{{desc.Render("★ " + formatCount(repo.StargazersCount)), 7, true}}
+	if d.team > 1 {
+		// Names are cut to fit, detail pane lists all of them
+		starredBy := fmt.Sprintf("%d/%d %s", len(i.starredBy), d.team, strings.Join(i.starredBy, ","))
+		optional = append(optional, column{desc.Render(starredBy), teamWidth, false})
+	}
+	optional = append(optional,
+		column{desc.Render("pushed " + formatAge(repo.PushedAt)), 12, false},
+		column{desc.Render("starred " + formatAge(i.star.StarredAt)), 13, false},
+	)
+	taken := func() int {
+		var n int
+		for _, c := range optional {
+			n += c.width + 1
+		}
+		return n
+	}
+	for len(optional) > 0 && width-indent-taken() < nameMinWidth {
+		optional = optional[:len(optional)-1]
+	}
+	nameWidth := width - indent - taken()
+	topicsWidth := 0
+	if nameWidth >= nameMinWidth+topicsMinWidth+1 {
+		topicsWidth = nameWidth - min(max(nameWidth/2, nameMinWidth), nameMaxWidth) - 1
+		nameWidth -= topicsWidth + 1
+	}
+
+	name := highlight(repo.FullName, fieldMatches(fields, 0, repo.FullName, matches), title, titleMatch)
+	if badges := repoBadges(i.star); len(badges) > 0 {
+		name += " " + strings.Join(badges, " ")
+	}
+	cells := append(prefix, fit(name, nameWidth, false))
+	for _, c := range optional {
+		cells = append(cells, " "+fit(c.text, c.width, c.right))
+	}
+
+	if topicsWidth > 0 {
+		var topics []string
+		for n, tag := range i.localTags {
+			field := 3 + len(i.tags) + n
+			topics = append(topics, desc.Render("#")+highlight(tag, fieldMatches(fields, field, tag, matches), desc, descMatch))
+		}
+		for n, topic := range i.tags {
+			topics = append(topics, highlight(topic, fieldMatches(fields, 3+n, topic, matches), desc, descMatch))
+		}
+		cells = append(cells, " "+fit(strings.Join(topics, " "), topicsWidth, false))
+	}
+
+	line := row.Render(strings.Join(cells, ""))
+	if d.compact {
+		fmt.Fprint(w, line)
+		return
+	}
+
+	about := fit(highlight(repo.Description, fieldMatches(fields, 1, repo.Description, matches), desc, descMatch), width-indent, false)
+	fmt.Fprintf(w, "%s\n%s", line, row.Render(strings.Repeat(" ", indent)+about))
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tmshv/ghstars/github"
+	"github.com/tmshv/ghstars/icons"
+)
+
+func TestFormatCount(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1k"},
+		{12345, "12.3k"},
+		{999949, "999.9k"},
+		{999950, "1M"},
+		{1500000, "1.5M"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := formatCount(tt.n); got != tt.expected {
+				t.Errorf("formatCount(%d) = %q, want %q", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		age      time.Duration
+		expected string
+	}{
+		{time.Hour, "today"},
+		{3 * day, "3d"},
+		{21 * day, "3w"},
+		{100 * day, "3mo"},
+		{800 * day, "2y"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := formatAge(time.Now().Add(-tt.age)); got != tt.expected {
+				t.Errorf("formatAge(-%v) = %q, want %q", tt.age, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFieldMatches(t *testing.T) {
+	fields := []string{"https://github.com/tmshv/ghstars", "GitHub stars", "Go", "cli"}
+	tests := []struct {
+		name     string
+		n        int
+		text     string
+		matches  []int
+		expected []int
+	}{
+		{"name inside url", 0, "tmshv/ghstars", []int{19, 20, 31}, []int{0, 1, 12}},
+		{"skips other fields", 0, "tmshv/ghstars", []int{33, 34}, nil},
+		{"second field", 1, "GitHub stars", []int{33, 40}, []int{0, 7}},
+		{"last field", 3, "cli", []int{49, 50, 51}, []int{0, 1, 2}},
+		{"no matches", 2, "Go", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fieldMatches(fields, tt.n, tt.text, tt.matches); !slices.Equal(got, tt.expected) {
+				t.Errorf("fieldMatches() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRenderWidth(t *testing.T) {
+	star := &github.GhStarV3{StarredAt: time.Now()}
+	star.Repo.FullName = "charmbracelet/bubbletea-with-a-rather-long-name"
+	star.Repo.HTMLURL = "https://github.com/" + star.Repo.FullName
+	star.Repo.Description = strings.Repeat("A powerful little TUI framework. ", 5)
+	star.Repo.Language = "Go"
+	star.Repo.Topics = []string{"cli", "tui", "elm-architecture", "framework"}
+	star.Repo.StargazersCount = 23456
+	star.Repo.PushedAt = time.Now()
+	item := newRepoItem(star, nil, []string{"alice"})
+
+	for _, compact := range []bool{false, true} {
+		for _, width := range []int{30, 60, 100, 160} {
+			d := newRepoDelegate(newSelection(), compact, 2)
+			l := list.New([]list.Item{item}, d, width, 20)
+			var b bytes.Buffer
+			d.Render(&b, l, 0, item)
+			lines := strings.Split(b.String(), "\n")
+			if len(lines) != d.Height() {
+				t.Errorf("compact=%v width=%d: got %d lines, want %d", compact, width, len(lines), d.Height())
+			}
+			for _, line := range lines {
+				if w := lipgloss.Width(line); w > width {
+					t.Errorf("compact=%v width=%d: line is %d wide: %q", compact, width, w, line)
+				}
+			}
+			if width >= 100 && !strings.Contains(lines[0], "1/2 alice") {
+				t.Errorf("compact=%v width=%d: starred by missing: %q", compact, width, lines[0])
+			}
+		}
+	}
+}
+
+func TestRepoBadges(t *testing.T) {
+	star := &github.GhStarV3{}
+	star.Repo.Archived = true
+	star.Repo.Fork = true
+
+	defer func(set icons.Set) { iconSet = set }(iconSet)
+	iconSet, _ = icons.Get("none")
+	badges := repoBadges(star)
+	if !slices.Equal(badges, []string{"[archived]", "[fork]"}) {
+		t.Errorf("repoBadges() = %q, want text states", badges)
+	}
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		text     string
+		width    int
+		right    bool
+		expected string
+	}{
+		{"go", 4, false, "go  "},
+		{"go", 4, true, "  go"},
+		{"★ 23.5k", 7, true, "★ 23.5k"},
+		{"bubbletea", 6, false, "bubbl…"},
+		{"", 0, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := fit(tt.text, tt.width, tt.right); got != tt.expected {
+				t.Errorf("fit(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.expected)
+			}
+		})
+	}
+}
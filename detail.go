@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Terminals at least this wide show the detail pane without asking
+const detailAutoWidth = 140
+
+type detailMode int
+
+const (
+	detailAuto detailMode = iota
+	detailOn
+	detailOff
+)
+
+// detailMinWidth is the narrowest detail pane worth showing
+const detailMinWidth = 40
+
+// detailWidth returns width of detail pane next to list of total width.
+// Zero hides the pane, list would not fit its name column otherwise.
+func detailWidth(total int) int {
+	width := min(max(total*2/5, detailMinWidth), total-nameMinWidth)
+	if width < detailMinWidth {
+		return 0
+	}
+	return width
+}
+
+func renderDetail(item *repoitem, width, height int) string {
+	style := detailStyle.
+		Width(width - detailStyle.GetHorizontalBorderSize()).
+		Height(height).
+		MaxHeight(height)
+	if item == nil {
+		return style.Render("No repository selected")
+	}
+	star := item.star
+	repo := star.Repo
+	inner := width - style.GetHorizontalFrameSize()
+
+	var blocks []string
+	blocks = append(blocks, detailTitleStyle.Render(repo.FullName))
+	if repo.Description != "" {
+		blocks = append(blocks, lipgloss.NewStyle().Width(inner).Render(repo.Description))
+	}
+	blocks = append(blocks, "")
+
+	row := func(label, value string) {
+		if value == "" {
+			return
+		}
+		value = lipgloss.NewStyle().Width(inner - detailLabelStyle.GetWidth()).Render(value)
+		blocks = append(blocks, lipgloss.JoinHorizontal(lipgloss.Top, detailLabelStyle.Render(label), value))
+	}
+	date := func(val time.Time) string {
+		return val.Format("2006-01-02")
+	}
+	flag := func(val bool) string {
+		if val {
+			return "yes"
+		}
+		return "no"
+	}
+
+	row("Homepage", repo.Homepage)
+	row("License", repo.License.Name)
+	row("Language", repo.Language)
+	row("Topics", strings.Join(repo.Topics, ", "))
+	row("Tags", strings.Join(item.localTags, ", "))
+	row("Note", item.note)
+	row("Stars", fmt.Sprintf("%d", repo.StargazersCount))
+	row("Forks", fmt.Sprintf("%d", repo.ForksCount))
+	row("Open issues", fmt.Sprintf("%d", repo.OpenIssuesCount))
+	row("Branch", repo.DefaultBranch)
+	row("Created", date(repo.CreatedAt))
+	row("Pushed", date(repo.PushedAt))
+	row("Updated", date(repo.UpdatedAt))
+	row("Starred", date(star.StarredAt))
+	row("Starred by", strings.Join(item.starredBy, ", "))
+	row("Fork", flag(repo.Fork))
+	row("Template", flag(repo.IsTemplate))
+	row("Archived", flag(repo.Archived))
+	blocks = append(blocks, "")
+	row("HTTPS", repo.CloneURL)
+	row("SSH", repo.SSHURL)
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, blocks...))
+}
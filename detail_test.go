@@ -0,0 +1,23 @@
+package main
+
+import "testing"
+
+func TestDetailWidth(t *testing.T) {
+	tests := []struct {
+		total    int
+		expected int
+	}{
+		{200, 80},
+		{100, 40},
+		{70, 40},
+		{60, 40},
+		{59, 0},
+		{10, 0},
+	}
+
+	for _, tt := range tests {
+		if result := detailWidth(tt.total); result != tt.expected {
+			t.Errorf("detailWidth(%d) = %d, want %d", tt.total, result, tt.expected)
+		}
+	}
+}
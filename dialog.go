@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type dialogKeyMap struct {
+	yes    key.Binding
+	no     key.Binding
+	submit key.Binding
+	cancel key.Binding
+}
+
+func newDialogKeyMap() *dialogKeyMap {
+	return &dialogKeyMap{
+		yes: key.NewBinding(
+			key.WithKeys("y", "enter"),
+			key.WithHelp("y", "confirm"),
+		),
+		no: key.NewBinding(
+			key.WithKeys("n", "esc"),
+			key.WithHelp("n", "cancel"),
+		),
+		submit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "submit"),
+		),
+		cancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+	}
+}
+
+// confirmDialog asks before running destructive action
+type confirmDialog struct {
+	visible bool
+	action  *action
+	items   []repoitem
+	keys    *dialogKeyMap
+}
+
+func newConfirmDialog(keys *dialogKeyMap) confirmDialog {
+	return confirmDialog{
+		keys: keys,
+	}
+}
+
+func (c *confirmDialog) open(act *action, items []repoitem) {
+	c.visible = true
+	c.action = act
+	c.items = items
+}
+
+func (c *confirmDialog) View() string {
+	target := fmt.Sprintf("%d repos", len(c.items))
+	if len(c.items) == 1 {
+		target = c.items[0].star.Repo.FullName
+	}
+	return actionMenuStyle.Render(fmt.Sprintf("%s %s?\n\n(y)es / (n)o", c.action.title, target))
+}
+
+// promptDialog reads a single line of text
+type promptDialog struct {
+	visible  bool
+	title    string
+	input    textinput.Model
+	onSubmit func(m *model, value string) tea.Cmd
+}
+
+func newPromptDialog() promptDialog {
+	ti := textinput.New()
+	ti.CharLimit = 256
+	ti.Width = 30
+	return promptDialog{
+		input: ti,
+	}
+}
+
+func (p *promptDialog) open(title, value string, onSubmit func(m *model, value string) tea.Cmd) tea.Cmd {
+	p.visible = true
+	p.title = title
+	p.onSubmit = onSubmit
+	p.input.SetValue(value)
+	p.input.CursorEnd()
+	return p.input.Focus()
+}
+
+func (p *promptDialog) close() {
+	p.visible = false
+	p.input.Blur()
+}
+
+func (p *promptDialog) View() string {
+	return actionMenuStyle.Render(fmt.Sprintf("%s\n\n%s", p.title, p.input.View()))
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/github"
+	"github.com/tmshv/ghstars/set"
+)
+
+// starSource is either a username or a snapshot file
+type starSource struct {
+	name     string
+	snapshot bool
+	stars    map[int]*github.GhStarV3
+}
+
+// isSnapshot tells snapshot file from username by its .json suffix or path
+// separator, usernames never have dots or slashes
+func isSnapshot(arg string) bool {
+	return strings.HasSuffix(arg, ".json") || strings.ContainsRune(arg, '/') || strings.ContainsRune(arg, filepath.Separator)
+}
+
+func loadStarSource(gh *github.Github, arg string) (*starSource, error) {
+	src := &starSource{name: arg, stars: map[int]*github.GhStarV3{}}
+	if isSnapshot(arg) {
+		src.snapshot = true
+		data, err := os.ReadFile(arg)
+		if err != nil {
+			return nil, err
+		}
+		var stars []github.GhStarV3
+		err = json.Unmarshal(data, &stars)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s: %w", arg, err)
+		}
+		for i := range stars {
+			src.stars[stars[i].Repo.ID] = &stars[i]
+		}
+		return src, nil
+	}
+
+	for res := range gh.GetStars(arg) {
+		star, err := res.Unwrap()
+		if err != nil {
+			return nil, err
+		}
+		src.stars[star.Repo.ID] = star
+	}
+	return src, nil
+}
+
+func (s *starSource) ids() *set.Set[int] {
+	ids := set.New[int]()
+	for id := range s.stars {
+		ids.Add(id)
+	}
+	return ids
+}
+
+// names returns sorted full names of repos
+func (s *starSource) names(ids *set.Set[int]) []string {
+	names := make([]string, 0, ids.Len())
+	for id := range ids.All() {
+		names = append(names, s.stars[id].Repo.FullName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func printSection(title string, names []string) {
+	fmt.Printf("%s (%d)\n", title, len(names))
+	for _, name := range names {
+		fmt.Printf("  %s\n", name)
+	}
+	fmt.Println()
+}
+
+// starChange reports relative change of star count in percents
+func starChange(before, after int) int {
+	if before == 0 {
+		if after == 0 {
+			return 0
+		}
+		return 100
+	}
+	return (after - before) * 100 / before
+}
+
+func snapshotCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Save stars of user to file for later diff",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			username, err := singleUser()
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				return errors.New("required flag \"output\" not set")
+			}
+			if !strings.HasSuffix(output, ".json") {
+				return fmt.Errorf("snapshot %s must end with .json to be told from username", output)
+			}
+			var stars []*github.GhStarV3
+			for res := range newGithub(cmd.Name()).GetStars(username) {
+				star, err := res.Unwrap()
+				if err != nil {
+					return err
+				}
+				stars = append(stars, star)
+			}
+			data, err := json.Marshal(stars)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(output, data, 0644)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Snapshot file ending with .json")
+	return cmd
+}
+
+func diffCommand() *cobra.Command {
+	var threshold int
+	cmd := &cobra.Command{
+		Use:   "diff <user|snapshot> <user|snapshot>",
+		Short: "Compare stars of two users or two snapshots",
+		Example: `  ghstars diff alice bob
+  ghstars snapshot -u alice -o old.json
+  ghstars diff old.json new.json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gh := newGithub(cmd.Name())
+			a, err := loadStarSource(gh, args[0])
+			if err != nil {
+				return err
+			}
+			b, err := loadStarSource(gh, args[1])
+			if err != nil {
+				return err
+			}
+
+			idsA, idsB := a.ids(), b.ids()
+			both := idsA.Intersect(idsB)
+			printSection(fmt.Sprintf("Only in %s", a.name), a.names(idsA.Difference(idsB)))
+			printSection(fmt.Sprintf("Only in %s", b.name), b.names(idsB.Difference(idsA)))
+			printSection("In both", a.names(both))
+
+			if !a.snapshot || !b.snapshot {
+				return nil
+			}
+
+			var archived, changed []string
+			for id := range both.All() {
+				before, after := a.stars[id].Repo, b.stars[id].Repo
+				if after.Archived && !before.Archived {
+					archived = append(archived, after.FullName)
+				}
+				if change := starChange(before.StargazersCount, after.StargazersCount); abs(change) >= threshold {
+					changed = append(changed, fmt.Sprintf("%s %d -> %d (%+d%%)", after.FullName, before.StargazersCount, after.StargazersCount, change))
+				}
+			}
+			sort.Strings(archived)
+			sort.Strings(changed)
+			printSection("Became archived", archived)
+			printSection(fmt.Sprintf("Stars changed by %d%% or more", threshold), changed)
+			return nil
+		},
+	}
+	cmd.Flags().IntVar(&threshold, "threshold", 50, "Report star count changes of at least this many percents")
+	return cmd
+}
+
+func abs(val int) int {
+	if val < 0 {
+		return -val
+	}
+	return val
+}
@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestIsSnapshot(t *testing.T) {
+	tests := []struct {
+		arg      string
+		expected bool
+	}{
+		{"alice", false},
+		{"go.mod", false},
+		{"old.json", true},
+		{"./old", true},
+		{"snapshots/old", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			if got := isSnapshot(tt.arg); got != tt.expected {
+				t.Errorf("isSnapshot(%q) = %v, want %v", tt.arg, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/tmshv/ghstars/github"
+)
+
+type exportRecord struct {
+	FullName    string    `json:"full_name"`
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	Language    string    `json:"language"`
+	Topics      []string  `json:"topics"`
+	Stars       int       `json:"stars"`
+	StarredAt   time.Time `json:"starred_at"`
+	Lists       []string  `json:"lists,omitempty"`
+}
+
+func newExportRecord(i repoitem, lists []string) exportRecord {
+	return exportRecord{
+		FullName:    i.star.Repo.FullName,
+		URL:         i.url,
+		Description: i.star.Repo.Description,
+		Language:    i.lang,
+		Topics:      i.tags,
+		Stars:       i.star.Repo.StargazersCount,
+		StarredAt:   i.star.StarredAt,
+		Lists:       lists,
+	}
+}
+
+func writeExport(filename string, items []repoitem, lists []github.StarList) error {
+	names := listNames(lists)
+	records := make([]exportRecord, 0, len(items))
+	for _, item := range items {
+		records = append(records, newExportRecord(item, names[item.star.Repo.ID]))
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0644)
+}
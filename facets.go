@@ -0,0 +1,271 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/tmshv/ghstars/set"
+)
+
+const facetPanelWidth = 32
+
+type facetKind int
+
+const (
+	facetLanguage facetKind = iota
+	facetTopic
+	facetLicense
+)
+
+func (k facetKind) String() string {
+	switch k {
+	case facetLanguage:
+		return "Languages"
+	case facetTopic:
+		return "Topics"
+	case facetLicense:
+		return "Licenses"
+	}
+	return "Unknown"
+}
+
+type facet struct {
+	kind  facetKind
+	value string
+}
+
+type facetCount struct {
+	facet
+	count int
+}
+
+// Facets of the same kind are combined with the panel mode (OR or AND),
+// facets of different kinds are always combined with AND.
+type facetMode int
+
+const (
+	facetOr facetMode = iota
+	facetAnd
+)
+
+func (m facetMode) String() string {
+	if m == facetAnd {
+		return "AND"
+	}
+	return "OR"
+}
+
+type facetKeyMap struct {
+	up     key.Binding
+	down   key.Binding
+	toggle key.Binding
+	mode   key.Binding
+	clear  key.Binding
+	leave  key.Binding
+}
+
+func newFacetKeyMap() *facetKeyMap {
+	return &facetKeyMap{
+		up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		toggle: key.NewBinding(
+			key.WithKeys(" ", "enter"),
+			key.WithHelp("space", "select facet"),
+		),
+		mode: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "toggle and/or"),
+		),
+		clear: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "clear facets"),
+		),
+		leave: key.NewBinding(
+			key.WithKeys("esc", "tab", "f"),
+			key.WithHelp("esc", "back to list"),
+		),
+	}
+}
+
+type facetPanel struct {
+	counts   *set.Counter[facet]
+	selected []facet
+	mode     facetMode
+	cursor   int
+	visible  bool
+	focused  bool
+	height   int
+	keys     *facetKeyMap
+}
+
+func newFacetPanel(keys *facetKeyMap) facetPanel {
+	return facetPanel{
+		counts: set.NewCounter[facet](),
+		keys:   keys,
+	}
+}
+
+func (i repoitem) facets() []facet {
+	var fs []facet
+	if i.lang != "" {
+		fs = append(fs, facet{kind: facetLanguage, value: i.lang})
+	}
+	for _, topic := range i.tags {
+		fs = append(fs, facet{kind: facetTopic, value: topic})
+	}
+	if i.license != "" {
+		fs = append(fs, facet{kind: facetLicense, value: i.license})
+	}
+	return fs
+}
+
+func (p *facetPanel) add(item repoitem) {
+	for _, f := range item.facets() {
+		p.counts.Add(f)
+	}
+}
+
+// entries returns facets grouped by kind and ranked by count
+func (p *facetPanel) entries() []facetCount {
+	entries := make([]facetCount, 0, p.counts.Len())
+	for f, count := range p.counts.All() {
+		entries = append(entries, facetCount{facet: f, count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.count != b.count {
+			return a.count > b.count
+		}
+		return a.value < b.value
+	})
+	return entries
+}
+
+func (p *facetPanel) isSelected(f facet) bool {
+	for _, s := range p.selected {
+		if s == f {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *facetPanel) toggle(f facet) {
+	for i, s := range p.selected {
+		if s == f {
+			p.selected = append(p.selected[:i], p.selected[i+1:]...)
+			return
+		}
+	}
+	p.selected = append(p.selected, f)
+}
+
+func (p *facetPanel) moveCursor(delta int) {
+	n := p.counts.Len()
+	if n == 0 {
+		p.cursor = 0
+		return
+	}
+	p.cursor = min(max(p.cursor+delta, 0), n-1)
+}
+
+// match reports whether item passes all selected facets
+func (p *facetPanel) match(item repoitem) bool {
+	if len(p.selected) == 0 {
+		return true
+	}
+	has := map[facet]bool{}
+	for _, f := range item.facets() {
+		has[f] = true
+	}
+	for _, kind := range []facetKind{facetLanguage, facetTopic, facetLicense} {
+		var total, matched int
+		for _, f := range p.selected {
+			if f.kind != kind {
+				continue
+			}
+			total++
+			if has[f] {
+				matched++
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		if p.mode == facetOr && matched == 0 {
+			return false
+		}
+		if p.mode == facetAnd && matched != total {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *facetPanel) chips() string {
+	if len(p.selected) == 0 {
+		return ""
+	}
+	chips := make([]string, 0, len(p.selected)+1)
+	chips = append(chips, p.mode.String())
+	for _, f := range p.selected {
+		chips = append(chips, facetChipStyle.Render(f.value))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, chips...)
+}
+
+func (p *facetPanel) View() string {
+	var lines []string
+	var cursorLine int
+	kind := facetKind(-1)
+	for i, e := range p.entries() {
+		if e.kind != kind {
+			kind = e.kind
+			lines = append(lines, facetHeaderStyle.Render(kind.String()))
+		}
+		mark := "[ ]"
+		if p.isSelected(e.facet) {
+			mark = "[x]"
+		}
+		line := truncate(fmt.Sprintf("%s %s (%d)", mark, e.value, e.count), facetPanelWidth-3)
+		switch {
+		case p.focused && i == p.cursor:
+			cursorLine = len(lines)
+			line = facetCursorStyle.Render("> " + line)
+		case p.isSelected(e.facet):
+			line = facetSelectedStyle.Render("  " + line)
+		default:
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+
+	// Scroll so the cursor stays visible
+	if p.height > 0 && len(lines) > p.height {
+		start := max(cursorLine-p.height/2, 0)
+		start = min(start, len(lines)-p.height)
+		lines = lines[start : start+p.height]
+	}
+
+	return facetPanelStyle.Height(p.height).Render(strings.Join(lines, "\n"))
+}
+
+func truncate(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	return string(r[:width-1]) + "…"
+}
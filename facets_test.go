@@ -0,0 +1,36 @@
+package main
+
+import "testing"
+
+func TestFacetMatch(t *testing.T) {
+	item := repoitem{lang: "Go", tags: []string{"cli", "tui"}, license: "MIT"}
+	lang := func(v string) facet { return facet{kind: facetLanguage, value: v} }
+	topic := func(v string) facet { return facet{kind: facetTopic, value: v} }
+	license := func(v string) facet { return facet{kind: facetLicense, value: v} }
+
+	tests := []struct {
+		name     string
+		mode     facetMode
+		selected []facet
+		expected bool
+	}{
+		{"empty selection", facetOr, nil, true},
+		{"empty selection and", facetAnd, nil, true},
+		{"or same kind hit", facetOr, []facet{lang("Rust"), lang("Go")}, true},
+		{"or same kind miss", facetOr, []facet{lang("Rust"), lang("C")}, false},
+		{"and same kind hit", facetAnd, []facet{topic("cli"), topic("tui")}, true},
+		{"and same kind miss", facetAnd, []facet{topic("cli"), topic("web")}, false},
+		{"cross kind hit", facetOr, []facet{lang("Go"), topic("web"), topic("tui"), license("MIT")}, true},
+		{"cross kind miss", facetOr, []facet{lang("Go"), license("GPL-3.0")}, false},
+		{"and cross kind miss", facetAnd, []facet{lang("Go"), topic("cli"), license("GPL-3.0")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := facetPanel{mode: tt.mode, selected: tt.selected}
+			if result := p.match(item); result != tt.expected {
+				t.Errorf("match() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -1,12 +1,17 @@
 package github
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/tmshv/ghstars/journal"
 )
 
 type GhStarV3 struct {
@@ -226,10 +231,26 @@ type GhStar struct {
 	DefaultBranch            string   `json:"default_branch"`
 }
 
+// Requests rejected by rate limit are repeated up to this number of times
+const maxAttempts = 3
+
+const DefaultAPIURL = "https://api.github.com"
+
 type Github struct {
 	token    string
+	apiURL   string
 	perpage  int
 	usecache bool
+	cacheTTL time.Duration
+	journal  *journal.Journal
+	limit    *rateLimit
+
+	// Star dates of authenticated user by lowercase repo name, read from
+	// /user/starred page by page and recorded to journal on unstar
+	mu           sync.Mutex
+	starredAt    map[string]time.Time
+	starredPages int
+	starredDone  bool
 }
 
 type result struct {
@@ -237,19 +258,47 @@ type result struct {
 	err error
 }
 
-func (r *result) Unwrap() (*GhStarV3, error) {
-	return &r.val, r.err
+// Unwrap returns pointer to own copy of star, so it stays valid
+// after the next result is received
+func (r result) Unwrap() (*GhStarV3, error) {
+	star := r.val
+	return &star, r.err
 }
 
 func (gh *Github) UseCache(val bool) {
 	gh.usecache = val
 }
 
-func (gh *Github) getCachedStars(filename string) ([]byte, error) {
-	_, err := os.Stat(filename)
+// SetCacheTTL makes cached files older than ttl to be fetched again, zero keeps them forever
+func (gh *Github) SetCacheTTL(ttl time.Duration) {
+	gh.cacheTTL = ttl
+}
+
+// SetAPIURL points client to GitHub Enterprise Server, e.g. https://github.example.com/api/v3
+func (gh *Github) SetAPIURL(url string) {
+	gh.apiURL = strings.TrimSuffix(url, "/")
+}
+
+func (gh *Github) url(format string, args ...any) string {
+	return gh.apiURL + fmt.Sprintf(format, args...)
+}
+
+// graphqlURL is /api/graphql on Enterprise Server and /graphql on github.com
+func (gh *Github) graphqlURL() string {
+	if base, ok := strings.CutSuffix(gh.apiURL, "/api/v3"); ok {
+		return base + "/api/graphql"
+	}
+	return gh.apiURL + "/graphql"
+}
+
+func (gh *Github) getCached(filename string) ([]byte, error) {
+	info, err := os.Stat(filename)
 	if err != nil {
 		return nil, err
 	}
+	if gh.cacheTTL > 0 && time.Since(info.ModTime()) > gh.cacheTTL {
+		return nil, fmt.Errorf("cache %s is older than %s", filename, gh.cacheTTL)
+	}
 
 	fileContent, err := os.ReadFile(filename)
 	if err != nil {
@@ -259,32 +308,67 @@ func (gh *Github) getCachedStars(filename string) ([]byte, error) {
 	return fileContent, nil
 }
 
-func (gh *Github) fetchStars(username string, page int) ([]byte, error) {
-	url := fmt.Sprintf("https://api.github.com/users/%s/starred?per_page=%d&page=%d", username, gh.perpage, page)
+func (gh *Github) request(method, url, accept string) ([]byte, error) {
+	return gh.send(method, url, accept, nil)
+}
+
+func (gh *Github) send(method, url, accept string, payload io.Reader) ([]byte, error) {
+	var body []byte
+	if payload != nil {
+		data, err := io.ReadAll(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = data
+	}
+
+	for attempt := 1; ; attempt++ {
+		gh.limit.wait()
+		data, retry, err := gh.do(method, url, accept, body)
+		if retry == 0 || attempt == maxAttempts {
+			return data, err
+		}
+		time.Sleep(retry)
+	}
+}
+
+// do sends single request, it returns delay to retry when rate limited
+func (gh *Github) do(method, url, accept string, body []byte) ([]byte, time.Duration, error) {
 	client := &http.Client{}
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	req.Header.Set("Authorization", "Bearer "+gh.token)
-	req.Header.Set("Accept", "application/vnd.github.v3.star+json")
+	req.Header.Set("Accept", accept)
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	gh.limit.update(resp)
+
+	if delay, ok := gh.limit.retryAfter(resp); ok {
+		resp.Body.Close()
+		return nil, max(delay, time.Second), fmt.Errorf("rate limit exceeded")
 	}
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		resp.Body.Close()
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, 0, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	data, err := io.ReadAll(resp.Body)
 	resp.Body.Close()
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return body, nil
+	return data, 0, nil
+}
+
+func (gh *Github) fetchStars(username string, page int) ([]byte, error) {
+	url := gh.url("/users/%s/starred?per_page=%d&page=%d", username, gh.perpage, page)
+	return gh.request("GET", url, "application/vnd.github.v3.star+json")
 }
 
 func (gh *Github) GetStars(username string) <-chan result {
@@ -301,7 +385,7 @@ func (gh *Github) GetStars(username string) <-chan result {
 
 			// Try to get cached page
 			if gh.usecache {
-				val, err := gh.getCachedStars(filename)
+				val, err := gh.getCached(filename)
 				if err == nil {
 					data = val
 					cached = true
@@ -348,8 +432,11 @@ func (gh *Github) GetStars(username string) <-chan result {
 
 func New(token string) *Github {
 	return &Github{
-		token:    token,
-		perpage:  100, // 100 is max
-		usecache: false,
+		token:     token,
+		apiURL:    DefaultAPIURL,
+		perpage:   100, // 100 is max
+		usecache:  false,
+		limit:     newRateLimit(),
+		starredAt: map[string]time.Time{},
 	}
 }
@@ -0,0 +1,111 @@
+package github
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// starsServer serves single page of starred repos and logs requests
+func starsServer(t *testing.T, page string) (*Github, *[]string) {
+	t.Helper()
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Query().Get("page") != "1" {
+			w.Write([]byte("[]"))
+			return
+		}
+		w.Write([]byte(page))
+	}))
+	t.Cleanup(srv.Close)
+	gh := New("token")
+	gh.SetAPIURL(srv.URL)
+	return gh, &requests
+}
+
+func TestGetStarsKeepsEveryStar(t *testing.T) {
+	gh, _ := starsServer(t, `[
+		{"repo": {"id": 1, "full_name": "a/one"}},
+		{"repo": {"id": 2, "full_name": "b/two"}},
+		{"repo": {"id": 3, "full_name": "c/three"}}
+	]`)
+
+	var stars []*GhStarV3
+	for res := range gh.GetStars("octocat") {
+		star, err := res.Unwrap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		stars = append(stars, star)
+	}
+
+	expected := []string{"a/one", "b/two", "c/three"}
+	if len(stars) != len(expected) {
+		t.Fatalf("got %d stars, want %d", len(stars), len(expected))
+	}
+	for i, name := range expected {
+		if stars[i].Repo.FullName != name {
+			t.Errorf("stars[%d] = %s, want %s", i, stars[i].Repo.FullName, name)
+		}
+	}
+}
+
+func TestUnwrapCopies(t *testing.T) {
+	r := &result{}
+	r.val.Repo.FullName = "a/one"
+	star, _ := r.Unwrap()
+	r.val.Repo.FullName = "b/two"
+	if star.Repo.FullName != "a/one" {
+		t.Errorf("Unwrap() = %s, want a/one", star.Repo.FullName)
+	}
+}
+
+func TestLookupStarredAt(t *testing.T) {
+	gh, requests := starsServer(t, `[
+		{"starred_at": "2020-01-02T00:00:00Z", "repo": {"id": 1, "full_name": "a/one"}},
+		{"starred_at": "2021-03-04T00:00:00Z", "repo": {"id": 2, "full_name": "b/two"}}
+	]`)
+
+	tests := []struct {
+		repo     string
+		expected string
+	}{
+		{"b/two", "2021-03-04"},
+		{"B/Two", "2021-03-04"},
+		{"c/three", "0001-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repo, func(t *testing.T) {
+			at, err := gh.lookupStarredAt(tt.repo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := at.Format("2006-01-02"); got != tt.expected {
+				t.Errorf("lookupStarredAt(%s) = %s, want %s", tt.repo, got, tt.expected)
+			}
+		})
+	}
+
+	// Both pages are read once, later lookups are answered from memory
+	if len(*requests) != 2 {
+		t.Errorf("got requests %v, want 2 pages", *requests)
+	}
+}
+
+func TestUnstarNotStarred(t *testing.T) {
+	gh, requests := starsServer(t, `[{"starred_at": "2020-01-02T00:00:00Z", "repo": {"id": 1, "full_name": "a/one"}}]`)
+
+	err := gh.Unstar("c", "three")
+	if !errors.Is(err, ErrNotStarred) {
+		t.Fatalf("Unstar() error = %v, want ErrNotStarred", err)
+	}
+	for _, r := range *requests {
+		if strings.HasPrefix(r, "DELETE") {
+			t.Errorf("sent %s for repo not starred", r)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type graphqlError struct {
+	Message string `json:"message"`
+}
+
+// graphql runs query and decodes its data into out
+func (gh *Github) graphql(query string, vars map[string]any, out any) error {
+	payload, err := json.Marshal(map[string]any{
+		"query":     query,
+		"variables": vars,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := gh.send("POST", gh.graphqlURL(), "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphqlError  `json:"errors"`
+	}
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		msgs := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return fmt.Errorf("graphql: %s", strings.Join(msgs, "; "))
+	}
+	return json.Unmarshal(resp.Data, out)
+}
+
+type pageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
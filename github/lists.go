@@ -0,0 +1,215 @@
+package github
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/tmshv/ghstars/journal"
+)
+
+// StarList is a user defined collection of stars
+type StarList struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	Repos       []int  `json:"repos"`
+}
+
+const listsQuery = `query($login: String!, $cursor: String) {
+  user(login: $login) {
+    lists(first: 100, after: $cursor) {
+      nodes { id name slug description }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+const listItemsQuery = `query($id: ID!, $cursor: String) {
+  node(id: $id) {
+    ... on UserList {
+      items(first: 100, after: $cursor) {
+        nodes { ... on Repository { databaseId } }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}`
+
+func (gh *Github) fetchLists(username string) ([]StarList, error) {
+	var lists []StarList
+	var cursor *string
+	for {
+		var data struct {
+			User struct {
+				Lists struct {
+					Nodes    []StarList `json:"nodes"`
+					PageInfo pageInfo   `json:"pageInfo"`
+				} `json:"lists"`
+			} `json:"user"`
+		}
+		err := gh.graphql(listsQuery, map[string]any{"login": username, "cursor": cursor}, &data)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, data.User.Lists.Nodes...)
+		if !data.User.Lists.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &data.User.Lists.PageInfo.EndCursor
+	}
+
+	for i := range lists {
+		repos, err := gh.fetchListItems(lists[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		lists[i].Repos = repos
+	}
+	return lists, nil
+}
+
+func (gh *Github) fetchListItems(id string) ([]int, error) {
+	var repos []int
+	var cursor *string
+	for {
+		var data struct {
+			Node struct {
+				Items struct {
+					Nodes []struct {
+						DatabaseID int `json:"databaseId"`
+					} `json:"nodes"`
+					PageInfo pageInfo `json:"pageInfo"`
+				} `json:"items"`
+			} `json:"node"`
+		}
+		err := gh.graphql(listItemsQuery, map[string]any{"id": id, "cursor": cursor}, &data)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range data.Node.Items.Nodes {
+			repos = append(repos, node.DatabaseID)
+		}
+		if !data.Node.Items.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &data.Node.Items.PageInfo.EndCursor
+	}
+	return repos, nil
+}
+
+// GetLists returns star lists of user with IDs of repos in each list
+func (gh *Github) GetLists(username string) ([]StarList, error) {
+	filename := fmt.Sprintf(".gh_%s_lists.json", username)
+
+	if gh.usecache {
+		data, err := gh.getCached(filename)
+		if err == nil {
+			var lists []StarList
+			err = json.Unmarshal(data, &lists)
+			if err == nil {
+				return lists, nil
+			}
+		}
+	}
+
+	lists, err := gh.fetchLists(username)
+	if err != nil {
+		return nil, err
+	}
+
+	if gh.usecache {
+		data, err := json.Marshal(lists)
+		if err == nil {
+			os.WriteFile(filename, data, 0644)
+		}
+	}
+
+	return lists, nil
+}
+
+const createListMutation = `mutation($name: String!) {
+  createUserList(input: {name: $name}) {
+    list { id name slug description }
+  }
+}`
+
+const deleteListMutation = `mutation($id: ID!) {
+  deleteUserList(input: {listId: $id}) { clientMutationId }
+}`
+
+const updateListsMutation = `mutation($item: ID!, $lists: [ID!]!) {
+  updateUserListsForItem(input: {itemId: $item, listIds: $lists}) { clientMutationId }
+}`
+
+// CreateList creates empty star list of the authenticated user
+func (gh *Github) CreateList(name string) (StarList, error) {
+	return gh.createList(name, 0)
+}
+
+func (gh *Github) createList(name string, undoes int) (StarList, error) {
+	var data struct {
+		CreateUserList struct {
+			List StarList `json:"list"`
+		} `json:"createUserList"`
+	}
+	err := gh.graphql(createListMutation, map[string]any{"name": name}, &data)
+	if err != nil {
+		return StarList{}, err
+	}
+	list := data.CreateUserList.List
+	return list, gh.record(journal.Entry{
+		Action: journal.CreateList,
+		List:   list.Name,
+		ListID: list.ID,
+		Undoes: undoes,
+	})
+}
+
+// DeleteList removes star list, repos stay starred
+func (gh *Github) DeleteList(list StarList) error {
+	return gh.deleteList(list.ID, list.Name, 0)
+}
+
+func (gh *Github) deleteList(id, name string, undoes int) error {
+	jid, err := gh.begin(journal.Entry{
+		Action: journal.DeleteList,
+		List:   name,
+		ListID: id,
+		Undoes: undoes,
+	})
+	if err != nil {
+		return err
+	}
+	var data any
+	err = gh.graphql(deleteListMutation, map[string]any{"id": id}, &data)
+	return cmp.Or(err, gh.finish(jid, err))
+}
+
+// SetRepoLists replaces lists containing repo. before holds IDs of lists
+// containing it now, so the change can be undone.
+func (gh *Github) SetRepoLists(repo, node string, before, after []string) error {
+	return gh.setRepoLists(repo, node, before, after, 0)
+}
+
+func (gh *Github) setRepoLists(repo, node string, before, after []string, undoes int) error {
+	if after == nil {
+		after = []string{}
+	}
+	id, err := gh.begin(journal.Entry{
+		Action: journal.SetLists,
+		Repo:   repo,
+		Node:   node,
+		Before: before,
+		After:  after,
+		Undoes: undoes,
+	})
+	if err != nil {
+		return err
+	}
+	var data any
+	err = gh.graphql(updateListsMutation, map[string]any{"item": node, "lists": after}, &data)
+	return cmp.Or(err, gh.finish(id, err))
+}
@@ -0,0 +1,29 @@
+package github
+
+import "encoding/json"
+
+// GetOrgMembers returns logins of public members of organization
+func (gh *Github) GetOrgMembers(org string) ([]string, error) {
+	var logins []string
+	for page := 1; ; page++ {
+		url := gh.url("/orgs/%s/public_members?per_page=%d&page=%d", org, gh.perpage, page)
+		data, err := gh.request("GET", url, "application/vnd.github+json")
+		if err != nil {
+			return nil, err
+		}
+		var members []struct {
+			Login string `json:"login"`
+		}
+		err = json.Unmarshal(data, &members)
+		if err != nil {
+			return nil, err
+		}
+		if len(members) == 0 {
+			break
+		}
+		for _, member := range members {
+			logins = append(logins, member.Login)
+		}
+	}
+	return logins, nil
+}
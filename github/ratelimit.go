@@ -0,0 +1,65 @@
+package github
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimit is shared by all requests of a client, so concurrent
+// fetches wait together once GitHub reports the limit is exhausted
+type rateLimit struct {
+	mu        sync.Mutex
+	remaining int
+	reset     time.Time
+}
+
+func newRateLimit() *rateLimit {
+	return &rateLimit{remaining: -1}
+}
+
+// wait blocks until the limit resets when no requests are left
+func (r *rateLimit) wait() {
+	r.mu.Lock()
+	var delay time.Duration
+	if r.remaining == 0 {
+		delay = time.Until(r.reset)
+	}
+	r.mu.Unlock()
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+func (r *rateLimit) update(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	r.remaining = remaining
+	r.reset = time.Unix(reset, 0)
+	r.mu.Unlock()
+}
+
+// retryAfter returns delay before request rejected by rate limit can be
+// repeated. It reports false when response is not a rate limit error.
+func (r *rateLimit) retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if sec, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(sec) * time.Second, true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.remaining == 0 {
+		return time.Until(r.reset), true
+	}
+	return 0, false
+}
@@ -0,0 +1,34 @@
+package github
+
+import (
+	"fmt"
+	"os"
+)
+
+func (gh *Github) fetchReadme(owner, repo string) ([]byte, error) {
+	url := gh.url("/repos/%s/%s/readme", owner, repo)
+	return gh.request("GET", url, "application/vnd.github.raw+json")
+}
+
+// GetReadme returns raw markdown of the repo README
+func (gh *Github) GetReadme(owner, repo string) (string, error) {
+	filename := fmt.Sprintf(".gh_readme_%s_%s.md", owner, repo)
+
+	if gh.usecache {
+		data, err := gh.getCached(filename)
+		if err == nil {
+			return string(data), nil
+		}
+	}
+
+	data, err := gh.fetchReadme(owner, repo)
+	if err != nil {
+		return "", err
+	}
+
+	if gh.usecache {
+		os.WriteFile(filename, data, 0644)
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,20 @@
+package github
+
+import "encoding/json"
+
+// RepoID returns ID of repo, annotations and caches are keyed by it
+func (gh *Github) RepoID(owner, repo string) (int, error) {
+	url := gh.url("/repos/%s/%s", owner, repo)
+	data, err := gh.request("GET", url, "application/vnd.github+json")
+	if err != nil {
+		return 0, err
+	}
+	var val struct {
+		ID int `json:"id"`
+	}
+	err = json.Unmarshal(data, &val)
+	if err != nil {
+		return 0, err
+	}
+	return val.ID, nil
+}
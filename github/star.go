@@ -0,0 +1,186 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tmshv/ghstars/journal"
+)
+
+func (gh *Github) starredURL(owner, repo string) string {
+	return gh.url("/user/starred/%s/%s", owner, repo)
+}
+
+// SetJournal makes every mutating call to be recorded in j
+func (gh *Github) SetJournal(j *journal.Journal) {
+	gh.journal = j
+}
+
+// Star adds repo to stars of the authenticated user
+func (gh *Github) Star(owner, repo string) error {
+	return gh.mutate(journal.Star, owner, repo, 0)
+}
+
+// Unstar removes repo from stars of the authenticated user
+func (gh *Github) Unstar(owner, repo string) error {
+	return gh.mutate(journal.Unstar, owner, repo, 0)
+}
+
+// Undo reverts journal entry. GitHub has no way to set star date,
+// so star restored by undo gets the current date. Deleted list is
+// restored empty.
+func (gh *Github) Undo(entry journal.Entry) error {
+	switch entry.Action {
+	case journal.CreateList:
+		return gh.deleteList(entry.ListID, entry.List, entry.ID)
+	case journal.DeleteList:
+		_, err := gh.createList(entry.List, entry.ID)
+		return err
+	case journal.SetLists:
+		return gh.setRepoLists(entry.Repo, entry.Node, entry.After, entry.Before, entry.ID)
+	}
+
+	owner, repo, ok := strings.Cut(entry.Repo, "/")
+	if !ok {
+		return fmt.Errorf("bad repo %q in journal entry %d", entry.Repo, entry.ID)
+	}
+	return gh.mutate(entry.Action.Inverse(), owner, repo, entry.ID)
+}
+
+// ErrNotStarred is returned when unstarring repo the authenticated user
+// has not starred
+var ErrNotStarred = errors.New("not starred")
+
+// GetOwnStars streams stars of the authenticated user. Destructive
+// commands use it, so it never reads cache.
+func (gh *Github) GetOwnStars() <-chan result {
+	ch := make(chan result)
+	go func() {
+		defer close(ch)
+		for page := 1; ; page++ {
+			stars, err := gh.fetchOwnStars(page)
+			if err != nil {
+				ch <- result{err: err}
+				return
+			}
+			if len(stars) == 0 {
+				return
+			}
+			for _, star := range stars {
+				ch <- result{val: star}
+			}
+		}
+	}()
+	return ch
+}
+
+// fetchOwnStars reads page of /user/starred and keeps star dates of it
+func (gh *Github) fetchOwnStars(page int) ([]GhStarV3, error) {
+	url := gh.url("/user/starred?per_page=%d&page=%d", gh.perpage, page)
+	data, err := gh.request("GET", url, "application/vnd.github.v3.star+json")
+	if err != nil {
+		return nil, err
+	}
+	var stars []GhStarV3
+	err = json.Unmarshal(data, &stars)
+	if err != nil {
+		return nil, err
+	}
+
+	gh.mu.Lock()
+	defer gh.mu.Unlock()
+	for _, star := range stars {
+		gh.starredAt[strings.ToLower(star.Repo.FullName)] = star.StarredAt
+	}
+	if page > gh.starredPages {
+		gh.starredPages = page
+		gh.starredDone = len(stars) == 0
+	}
+	return stars, nil
+}
+
+// lookupStarredAt returns star date of repo by the authenticated user,
+// zero time means repo is not starred. Pages of /user/starred are read
+// only until repo is found and kept for later lookups.
+func (gh *Github) lookupStarredAt(fullname string) (time.Time, error) {
+	key := strings.ToLower(fullname)
+	for {
+		gh.mu.Lock()
+		at, ok := gh.starredAt[key]
+		done, page := gh.starredDone, gh.starredPages+1
+		gh.mu.Unlock()
+		if ok || done {
+			return at, nil
+		}
+		_, err := gh.fetchOwnStars(page)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+}
+
+func (gh *Github) mutate(action journal.Action, owner, repo string, undoes int) error {
+	fullname := owner + "/" + repo
+	var starredAt time.Time
+	method := "PUT"
+	if action == journal.Unstar {
+		method = "DELETE"
+		// Journal keeps original star date, it is gone after unstar
+		at, err := gh.lookupStarredAt(fullname)
+		if err != nil {
+			return fmt.Errorf("look up star of %s: %w", fullname, err)
+		}
+		if at.IsZero() {
+			return fmt.Errorf("%s: %w", fullname, ErrNotStarred)
+		}
+		starredAt = at
+	}
+	id, err := gh.begin(journal.Entry{
+		Action:    action,
+		Repo:      fullname,
+		StarredAt: starredAt,
+		Undoes:    undoes,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = gh.request(method, gh.starredURL(owner, repo), "application/vnd.github+json")
+	ferr := gh.finish(id, err)
+	if err != nil {
+		return err
+	}
+
+	gh.mu.Lock()
+	if action == journal.Unstar {
+		delete(gh.starredAt, strings.ToLower(fullname))
+	} else {
+		gh.starredAt[strings.ToLower(fullname)] = time.Now()
+	}
+	gh.mu.Unlock()
+	return ferr
+}
+
+func (gh *Github) record(entry journal.Entry) error {
+	if gh.journal == nil {
+		return nil
+	}
+	return gh.journal.Record(entry)
+}
+
+// begin journals entry before its call, finish marks it done after
+func (gh *Github) begin(entry journal.Entry) (int, error) {
+	if gh.journal == nil {
+		return 0, nil
+	}
+	return gh.journal.Begin(entry)
+}
+
+func (gh *Github) finish(id int, err error) error {
+	if gh.journal == nil {
+		return nil
+	}
+	return gh.journal.Finish(id, err)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/github"
+	"github.com/tmshv/ghstars/journal"
+)
+
+const journalFile = ".gh_journal.jsonl"
+
+func formatEntry(e journal.Entry, undone bool) string {
+	target := e.Repo
+	if e.List != "" {
+		target = e.List
+	}
+	line := fmt.Sprintf("#%-4d %s  %-11s  %s", e.ID, e.Time.Format("2006-01-02 15:04"), e.Action, target)
+	if !e.StarredAt.IsZero() {
+		line += fmt.Sprintf(" (starred %s)", e.StarredAt.Format("2006-01-02"))
+	}
+	if e.Undoes != 0 {
+		line += fmt.Sprintf(" undoes #%d", e.Undoes)
+	}
+	switch {
+	case e.Failed != "":
+		line += fmt.Sprintf(" [failed: %s]", e.Failed)
+	case e.Unconfirmed:
+		line += " [unconfirmed]"
+	case undone:
+		line += " [undone]"
+	}
+	return fmt.Sprintf("%s  via %s", line, e.Command)
+}
+
+// undoEntries reverts entries starting from the latest one
+func undoEntries(gh *github.Github, entries []journal.Entry) error {
+	if len(entries) == 0 {
+		fmt.Println("nothing to undo")
+		return nil
+	}
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		err := gh.Undo(e)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("undone #%d %s %s%s\n", e.ID, e.Action, e.Repo, e.List)
+	}
+	return nil
+}
+
+func historyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "history",
+		Short: "Show journal of star and unstar operations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entries, err := journal.Open(journalFile, cmd.Name()).Entries()
+			if err != nil {
+				return err
+			}
+			undone := journal.Undone(entries)
+			for _, e := range entries {
+				fmt.Println(formatEntry(e, undone[e.ID]))
+			}
+			return nil
+		},
+	}
+}
+
+func undoCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "undo [N]",
+		Short: "Revert last N star and unstar operations",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			n := 1
+			if len(args) == 1 {
+				val, err := strconv.Atoi(args[0])
+				if err != nil || val < 1 {
+					return fmt.Errorf("bad number of operations %q", args[0])
+				}
+				n = val
+			}
+
+			gh := newGithub(cmd.Name())
+			entries, err := journal.Open(journalFile, cmd.Name()).Entries()
+			if err != nil {
+				return err
+			}
+			pending := journal.Pending(entries)
+			return undoEntries(gh, pending[max(len(pending)-n, 0):])
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package icons
+
+import (
+	"strings"
+	"unicode"
+)
+
+var abbrevs = map[string]string{
+	"C":                "c",
+	"C#":               "cs",
+	"C++":              "cpp",
+	"CSS":              "css",
+	"Clojure":          "clj",
+	"Dart":             "dart",
+	"Dockerfile":       "dock",
+	"Elixir":           "ex",
+	"Elm":              "elm",
+	"Emacs Lisp":       "el",
+	"Erlang":           "erl",
+	"Go":               "go",
+	"HTML":             "html",
+	"Haskell":          "hs",
+	"Java":             "java",
+	"JavaScript":       "js",
+	"Jupyter Notebook": "ipynb",
+	"Kotlin":           "kt",
+	"Lua":              "lua",
+	"Nix":              "nix",
+	"OCaml":            "ml",
+	"Objective-C":      "objc",
+	"PHP":              "php",
+	"Perl":             "pl",
+	"Python":           "py",
+	"R":                "r",
+	"Reason":           "re",
+	"Ruby":             "rb",
+	"Rust":             "rs",
+	"Scala":            "scala",
+	"Shell":            "sh",
+	"Svelte":           "svlt",
+	"Swift":            "swift",
+	"TypeScript":       "ts",
+	"Vim Script":       "vim",
+	"Vue":              "vue",
+	"Zig":              "zig",
+}
+
+type ascii struct{}
+
+func (ascii) Lang(val string) string {
+	return abbrev(val)
+}
+
+// ASCII shows short lowercase abbreviations like file extensions
+func ASCII() Set {
+	return ascii{}
+}
+
+// abbrev falls back to first letters of unknown language
+func abbrev(val string) string {
+	if name, _, _ := lookup(val); abbrevs[name] != "" {
+		return abbrevs[name]
+	}
+	var b strings.Builder
+	for _, r := range strings.ToLower(val) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+		}
+		if b.Len() == 4 {
+			break
+		}
+	}
+	return b.String()
+}
+
+var asciiStates = map[State]string{
+	Archived: "[arch]",
+	Fork:     "[fork]",
+	Template: "[tmpl]",
+	Disabled: "[off]",
+	Mirror:   "[mirr]",
+}
+
+var asciiTopics = map[string]string{
+	"cli":              "cli",
+	"tui":              "tui",
+	"database":         "db",
+	"machine-learning": "ml",
+	"ai":               "ai",
+	"web":              "web",
+	"api":              "api",
+	"security":         "sec",
+	"game":             "game",
+	"docker":           "dock",
+	"kubernetes":       "k8s",
+	"linux":            "linux",
+	"macos":            "mac",
+	"windows":          "win",
+	"mobile":           "mob",
+	"editor":           "edit",
+	"testing":          "test",
+	"documentation":    "docs",
+	"self-hosted":      "self",
+	"awesome":          "awe",
+	"compiler":         "cc",
+}
+
+func (ascii) State(s State) string {
+	return asciiStates[s]
+}
+
+func (ascii) License(spdx string) string {
+	return LicenseFamily(spdx)
+}
+
+func (ascii) Topic(topic string) string {
+	kind, _ := TopicKind(topic)
+	return asciiTopics[kind]
+}
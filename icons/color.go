@@ -0,0 +1,72 @@
+package icons
+
+import "github.com/charmbracelet/lipgloss"
+
+// Used for languages linguist has no color for
+const colorFallback = "#cccccc"
+
+type color struct{}
+
+func (color) Lang(val string) string {
+	if val == "" {
+		return ""
+	}
+	_, lang, _ := lookup(val)
+	c := lang.color
+	if c == "" {
+		c = colorFallback
+	}
+	return colored("●", c) + " " + abbrev(val)
+}
+
+// Color shows dot colored like language bar on GitHub followed by abbreviation
+func Color() Set {
+	return color{}
+}
+
+var colorStates = map[State]string{
+	Archived: "#E5C07B",
+	Fork:     "#888888",
+	Template: "#61AFEF",
+	Disabled: "#E06C75",
+	Mirror:   "#888888",
+}
+
+// Permissive licenses are green, copyleft ones are red
+var colorLicenses = map[string]string{
+	"MIT":    "#98C379",
+	"BSD":    "#98C379",
+	"Apache": "#98C379",
+	"PD":     "#56B6C2",
+	"MPL":    "#E5C07B",
+	"LGPL":   "#E5C07B",
+	"GPL":    "#E06C75",
+}
+
+func colored(text, c string) string {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(c)).Render(text)
+}
+
+func (color) State(s State) string {
+	return colored(asciiStates[s], colorStates[s])
+}
+
+func (color) License(spdx string) string {
+	family := LicenseFamily(spdx)
+	if family == "" {
+		return ""
+	}
+	c, ok := colorLicenses[family]
+	if !ok {
+		c = colorFallback
+	}
+	return colored(family, c)
+}
+
+func (color) Topic(topic string) string {
+	kind, _ := TopicKind(topic)
+	if asciiTopics[kind] == "" {
+		return ""
+	}
+	return colored(asciiTopics[kind], colorFallback)
+}
@@ -0,0 +1,103 @@
+package icons
+
+// Used for languages without own emoji
+const emojiFallback = "📄"
+
+type emoji struct {
+	m map[string]string
+}
+
+func (i *emoji) Lang(val string) string {
+	if val == "" {
+		return ""
+	}
+	name, _, _ := lookup(val)
+	if e, ok := i.m[name]; ok {
+		return e
+	}
+	return emojiFallback
+}
+
+func Emoji() *emoji {
+	return &emoji{
+		m: map[string]string{
+			"C":                "🇨",
+			"C++":              "➕",
+			"Clojure":          "🔁",
+			"Dart":             "🎯",
+			"Dockerfile":       "🐳",
+			"Elixir":           "💧",
+			"Go":               "🐹",
+			"HTML":             "🌐",
+			"Haskell":          "🎩",
+			"Java":             "☕",
+			"JavaScript":       "🟨",
+			"Jupyter Notebook": "📓",
+			"Kotlin":           "🟪",
+			"Lua":              "🌙",
+			"Nix":              "❄️",
+			"OCaml":            "🐫",
+			"PHP":              "🐘",
+			"Python":           "🐍",
+			"R":                "📊",
+			"Reason":           "🧠",
+			"Ruby":             "💎",
+			"Rust":             "🦀",
+			"Scala":            "🪜",
+			"Shell":            "🐚",
+			"Swift":            "🐦",
+			"TypeScript":       "🟦",
+			"Vim Script":       "📝",
+			"Zig":              "⚡",
+		},
+	}
+}
+
+var emojiStates = map[State]string{
+	Archived: "📦",
+	Fork:     "🍴",
+	Template: "📋",
+	Disabled: "🚫",
+	Mirror:   "🪞",
+}
+
+var emojiTopics = map[string]string{
+	"cli":              "💻",
+	"tui":              "🖥",
+	"database":         "🗄",
+	"machine-learning": "🧠",
+	"ai":               "🤖",
+	"web":              "🌐",
+	"api":              "🔌",
+	"security":         "🔒",
+	"game":             "🎮",
+	"docker":           "🐳",
+	"kubernetes":       "☸",
+	"linux":            "🐧",
+	"macos":            "🍎",
+	"windows":          "🪟",
+	"mobile":           "📱",
+	"editor":           "✏",
+	"testing":          "🧪",
+	"documentation":    "📚",
+	"self-hosted":      "🏠",
+	"awesome":          "😎",
+	"compiler":         "⚙",
+}
+
+func (i *emoji) State(s State) string {
+	return emojiStates[s]
+}
+
+func (i *emoji) License(spdx string) string {
+	family := LicenseFamily(spdx)
+	if family == "" {
+		return ""
+	}
+	return "⚖ " + family
+}
+
+func (i *emoji) Topic(topic string) string {
+	kind, _ := TopicKind(topic)
+	return emojiTopics[kind]
+}
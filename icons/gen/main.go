@@ -0,0 +1,118 @@
+// Command gen builds language icon and color table from linguist data.
+// It is run by go generate in icons package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type linguistLanguage struct {
+	Type    string   `yaml:"type"`
+	Color   string   `yaml:"color"`
+	Aliases []string `yaml:"aliases"`
+}
+
+func load(filename string, out any) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(data, out)
+	if err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	return nil
+}
+
+func generate(languages map[string]linguistLanguage, nerd map[string]rune) ([]byte, error) {
+	for name := range nerd {
+		if _, ok := languages[name]; !ok {
+			return nil, fmt.Errorf("nerd icon for unknown language %q", name)
+		}
+	}
+
+	names := make([]string, 0, len(languages))
+	for name, lang := range languages {
+		if lang.Color == "" && nerd[name] == 0 {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Linguist matches language names case-insensitively, so lowercase
+	// names are aliases too
+	aliases := map[string]string{}
+	for _, name := range names {
+		for _, alias := range append([]string{name}, languages[name].Aliases...) {
+			alias = strings.ToLower(alias)
+			if other, ok := aliases[alias]; ok && other != name {
+				return nil, fmt.Errorf("alias %q of %q is already used by %q", alias, name, other)
+			}
+			aliases[alias] = name
+		}
+	}
+	keys := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		keys = append(keys, alias)
+	}
+	sort.Strings(keys)
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by icons/gen from data/languages.yml and data/nerd.yml; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package icons")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var languages = map[string]language{")
+	for _, name := range names {
+		if icon := nerd[name]; icon != 0 {
+			fmt.Fprintf(&b, "%q: {icon: %#x, color: %q},\n", name, icon, languages[name].Color)
+		} else {
+			fmt.Fprintf(&b, "%q: {color: %q},\n", name, languages[name].Color)
+		}
+	}
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "var aliases = map[string]string{")
+	for _, alias := range keys {
+		fmt.Fprintf(&b, "%q: %q,\n", alias, aliases[alias])
+	}
+	fmt.Fprintln(&b, "}")
+	return format.Source(b.Bytes())
+}
+
+func main() {
+	languagesFile := flag.String("languages", "data/languages.yml", "Linguist languages file")
+	nerdFile := flag.String("nerd", "data/nerd.yml", "Nerd Font codepoints by language")
+	output := flag.String("o", "languages_gen.go", "Output file")
+	flag.Parse()
+
+	var languages map[string]linguistLanguage
+	err := load(*languagesFile, &languages)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var nerd map[string]rune
+	err = load(*nerdFile, &nerd)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := generate(languages, nerd)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile(*output, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
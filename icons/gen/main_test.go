@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		languages map[string]linguistLanguage
+		nerd      map[string]rune
+		contains  []string
+		err       string
+	}{
+		{
+			name: "icon and aliases",
+			languages: map[string]linguistLanguage{
+				"Shell": {Color: "#89e051", Aliases: []string{"sh", "Bash"}},
+			},
+			nerd: map[string]rune{"Shell": 0xe795},
+			contains: []string{
+				`"Shell": {icon: 0xe795, color: "#89e051"}`,
+				`"bash":  "Shell"`,
+				`"shell": "Shell"`,
+			},
+		},
+		{
+			name: "skips languages without color or icon",
+			languages: map[string]linguistLanguage{
+				"Go":   {Color: "#00ADD8"},
+				"Text": {Type: "prose"},
+			},
+			contains: []string{`"Go": {color: "#00ADD8"}`},
+		},
+		{
+			name:      "icon of unknown language",
+			languages: map[string]linguistLanguage{},
+			nerd:      map[string]rune{"Go": 0xe65e},
+			err:       "unknown language",
+		},
+		{
+			name: "duplicate alias",
+			languages: map[string]linguistLanguage{
+				"A": {Color: "#000000", Aliases: []string{"x"}},
+				"B": {Color: "#000000", Aliases: []string{"x"}},
+			},
+			err: "already used",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, err := generate(tt.languages, tt.nerd)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("generate() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(string(src), s) {
+					t.Errorf("generated source misses %s:\n%s", s, src)
+				}
+			}
+			if strings.Contains(string(src), `"Text"`) {
+				t.Errorf("generated source has language without color")
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package icons
+
+import (
+	"fmt"
+	"strings"
+)
+
+var sets = map[string]func() Set{
+	"nerd":  func() Set { return Nerd() },
+	"emoji": func() Set { return Emoji() },
+	"ascii": func() Set { return ASCII() },
+	"color": func() Set { return Color() },
+	"none":  func() Set { return None() },
+}
+
+// Names lists icon sets accepted by Get
+func Names() []string {
+	return []string{"nerd", "emoji", "ascii", "color", "none"}
+}
+
+func Get(name string) (Set, error) {
+	set, ok := sets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown icon set %q, expected one of %s", name, strings.Join(Names(), ", "))
+	}
+	return set(), nil
+}
+
+type none struct{}
+
+func (none) Lang(string) string {
+	return ""
+}
+
+func (none) State(s State) string {
+	return ""
+}
+
+func (none) License(string) string {
+	return ""
+}
+
+func (none) Topic(string) string {
+	return ""
+}
+
+// None hides all icons, repo states fall back to text badges like [archived]
+func None() Set {
+	return none{}
+}
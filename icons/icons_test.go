@@ -0,0 +1,41 @@
+package icons
+
+import (
+	"testing"
+)
+
+func TestSets(t *testing.T) {
+	tests := []struct {
+		set      string
+		lang     string
+		expected string
+	}{
+		{"ascii", "Rust", "rs"},
+		{"ascii", "Jupyter Notebook", "ipynb"},
+		{"ascii", "Common Lisp", "comm"},
+		{"ascii", "", ""},
+		{"emoji", "Python", "🐍"},
+		{"emoji", "Unknown", emojiFallback},
+		{"emoji", "", ""},
+		{"color", "Go", "● go"},
+		{"color", "", ""},
+		{"none", "Go", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.set+"/"+tt.lang, func(t *testing.T) {
+			icon, err := Get(tt.set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := icon.Lang(tt.lang)
+			if result != tt.expected {
+				t.Errorf("Lang(%q) = %q, want %q", tt.lang, result, tt.expected)
+			}
+		})
+	}
+
+	if _, err := Get("unknown"); err == nil {
+		t.Error("Get(\"unknown\") expected error")
+	}
+}
@@ -1,5 +1,26 @@
 package icons
 
+import "strings"
+
+//go:generate go run ./gen
+
 type LangIcon interface {
 	Lang(string) string
 }
+
+type language struct {
+	icon  rune
+	color string
+}
+
+// lookup finds language by linguist name or alias
+func lookup(val string) (string, language, bool) {
+	if lang, ok := languages[val]; ok {
+		return val, lang, true
+	}
+	name, ok := aliases[strings.ToLower(val)]
+	if !ok {
+		return val, language{}, false
+	}
+	return name, languages[name], true
+}
@@ -0,0 +1,56 @@
+package icons
+
+import (
+	"regexp"
+	"testing"
+	"unicode/utf8"
+)
+
+// Nerd Fonts place glyphs in private use areas
+func isPrivateUse(r rune) bool {
+	return (r >= 0xe000 && r <= 0xf8ff) || (r >= 0xf0000 && r <= 0xffffd)
+}
+
+func TestLanguages(t *testing.T) {
+	hex := regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	for name, lang := range languages {
+		if lang.icon != 0 && (!utf8.ValidRune(lang.icon) || !isPrivateUse(lang.icon)) {
+			t.Errorf("%s: icon %#x is not a valid Nerd Font rune", name, lang.icon)
+		}
+		if lang.color != "" && !hex.MatchString(lang.color) {
+			t.Errorf("%s: color %q is not #rrggbb", name, lang.color)
+		}
+	}
+	for alias, name := range aliases {
+		if _, ok := languages[name]; !ok {
+			t.Errorf("alias %q points to unknown language %q", alias, name)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		val      string
+		expected string
+		ok       bool
+	}{
+		{"Go", "Go", true},
+		{"golang", "Go", true},
+		{"Bash", "Shell", true},
+		{"zsh", "Shell", true},
+		{"IPython Notebook", "Jupyter Notebook", true},
+		{"jupyter notebook", "Jupyter Notebook", true},
+		{"C++", "C++", true},
+		{"cpp", "C++", true},
+		{"Unknown", "Unknown", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.val, func(t *testing.T) {
+			name, _, ok := lookup(tt.val)
+			if name != tt.expected || ok != tt.ok {
+				t.Errorf("lookup(%q) = %q, %v, want %q, %v", tt.val, name, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,552 @@
+// Code generated by icons/gen from data/languages.yml and data/nerd.yml; DO NOT EDIT.
+
+package icons
+
+var languages = map[string]language{
+	"ABAP":               {color: "#E8274B"},
+	"ActionScript":       {color: "#882B0F"},
+	"Ada":                {color: "#02f88c"},
+	"Agda":               {color: "#315665"},
+	"AppleScript":        {color: "#101F1F"},
+	"Assembly":           {color: "#6E4C13"},
+	"Astro":              {color: "#ff5a03"},
+	"AutoHotkey":         {color: "#6594b9"},
+	"Awk":                {color: "#c30e9b"},
+	"Ballerina":          {color: "#FF5000"},
+	"Batchfile":          {color: "#C1F12E"},
+	"Bicep":              {color: "#519aba"},
+	"Blade":              {color: "#f7523f"},
+	"C":                  {icon: 0xe649, color: "#555555"},
+	"C#":                 {icon: 0xf031b, color: "#178600"},
+	"C++":                {icon: 0xf0646, color: "#f34b7d"},
+	"CMake":              {color: "#DA3434"},
+	"CSS":                {icon: 0xe749, color: "#563d7c"},
+	"Cairo":              {color: "#ff4a48"},
+	"Ceylon":             {color: "#dfa535"},
+	"Chapel":             {color: "#8dc63f"},
+	"Clojure":            {icon: 0xe768, color: "#db5855"},
+	"CoffeeScript":       {icon: 0xe751, color: "#244776"},
+	"Common Lisp":        {color: "#3fb68b"},
+	"Crystal":            {icon: 0xe62f, color: "#000100"},
+	"Cuda":               {color: "#3A4E3A"},
+	"D":                  {icon: 0xe7af, color: "#ba595e"},
+	"Dart":               {icon: 0xe798, color: "#00B4AB"},
+	"Dhall":              {color: "#dfafff"},
+	"Dockerfile":         {icon: 0xe7b0, color: "#384d54"},
+	"Elixir":             {icon: 0xe62d, color: "#6e4a7e"},
+	"Elm":                {icon: 0xe62c, color: "#60B5CC"},
+	"Elvish":             {color: "#55BB55"},
+	"Emacs Lisp":         {icon: 0xe632, color: "#c065db"},
+	"Erlang":             {icon: 0xe7b1, color: "#B83998"},
+	"F#":                 {color: "#b845fc"},
+	"Fennel":             {color: "#fff3d7"},
+	"Fortran":            {color: "#4d41b1"},
+	"GDScript":           {color: "#355570"},
+	"GLSL":               {color: "#5686a5"},
+	"Gherkin":            {color: "#5B2063"},
+	"Gleam":              {color: "#ffaff3"},
+	"Go":                 {icon: 0xe65e, color: "#00ADD8"},
+	"GraphQL":            {icon: 0xe662, color: "#e10098"},
+	"Groovy":             {icon: 0xe775, color: "#4298b8"},
+	"HCL":                {color: "#844FBA"},
+	"HLSL":               {color: "#aace60"},
+	"HTML":               {icon: 0xe736, color: "#e34c26"},
+	"Hack":               {color: "#878787"},
+	"Haml":               {color: "#ece2a9"},
+	"Handlebars":         {color: "#f7931e"},
+	"Haskell":            {icon: 0xe777, color: "#5e5086"},
+	"Haxe":               {color: "#df7900"},
+	"Hy":                 {color: "#7790B2"},
+	"Idris":              {color: "#b30000"},
+	"Inno Setup":         {color: "#264b99"},
+	"Io":                 {color: "#a9188d"},
+	"J":                  {color: "#9EEDFF"},
+	"Janet":              {color: "#0886a5"},
+	"Java":               {icon: 0xe738, color: "#b07219"},
+	"JavaScript":         {icon: 0xe60c, color: "#f1e05a"},
+	"Jolie":              {color: "#843179"},
+	"Jsonnet":            {color: "#0064bd"},
+	"Julia":              {icon: 0xe624, color: "#a270ba"},
+	"Jupyter Notebook":   {icon: 0xf082e, color: "#DA5B0B"},
+	"Just":               {color: "#384d54"},
+	"Kakoune Script":     {color: "#6f8042"},
+	"Kotlin":             {icon: 0xe634, color: "#A97BFF"},
+	"LFE":                {color: "#4C3023"},
+	"LLVM":               {color: "#185619"},
+	"LOLCODE":            {color: "#cc9900"},
+	"Less":               {color: "#1d365d"},
+	"Lex":                {color: "#DBCA00"},
+	"Liquid":             {color: "#67b8de"},
+	"Literate Haskell":   {color: "#5e5086"},
+	"LiveScript":         {color: "#499886"},
+	"Lua":                {icon: 0xe620, color: "#000080"},
+	"Luau":               {color: "#00A2FF"},
+	"MATLAB":             {color: "#e16737"},
+	"MDX":                {color: "#fcb32c"},
+	"Makefile":           {icon: 0xe779, color: "#427819"},
+	"Mako":               {color: "#7e858d"},
+	"Markdown":           {icon: 0xe73e, color: "#083fa1"},
+	"Mask":               {color: "#f97732"},
+	"Max":                {color: "#c4a79c"},
+	"Mercury":            {color: "#ff2b2b"},
+	"Meson":              {color: "#007800"},
+	"Metal":              {color: "#8f14e9"},
+	"Modelica":           {color: "#de1d31"},
+	"Mojo":               {color: "#ff4c1f"},
+	"MoonScript":         {color: "#ff4585"},
+	"Move":               {color: "#4a137a"},
+	"NewLisp":            {color: "#87AED7"},
+	"Nextflow":           {color: "#3ac486"},
+	"Nim":                {icon: 0xe677, color: "#ffc200"},
+	"Nit":                {color: "#009917"},
+	"Nix":                {icon: 0xf1105, color: "#7e7eff"},
+	"Nu":                 {color: "#c9df40"},
+	"Nunjucks":           {color: "#3d8137"},
+	"Nushell":            {color: "#4E9906"},
+	"OCaml":              {icon: 0xe67a, color: "#ef7a08"},
+	"Objective-C":        {color: "#438eff"},
+	"Objective-C++":      {color: "#6866fb"},
+	"Odin":               {color: "#60AFFE"},
+	"Opal":               {color: "#f7ede0"},
+	"OpenSCAD":           {color: "#e5cd45"},
+	"Oxygene":            {color: "#cdd0e3"},
+	"Oz":                 {color: "#fab738"},
+	"P4":                 {color: "#7055b5"},
+	"PHP":                {icon: 0xe73d, color: "#4F5D95"},
+	"PLSQL":              {color: "#dad8d8"},
+	"PLpgSQL":            {color: "#336790"},
+	"Papyrus":            {color: "#6600cc"},
+	"Parrot":             {color: "#f3ca0a"},
+	"Pascal":             {color: "#E3F171"},
+	"Pawn":               {color: "#dbb284"},
+	"Perl":               {icon: 0xe769, color: "#0298c3"},
+	"PigLatin":           {color: "#fcd7de"},
+	"PogoScript":         {color: "#d80074"},
+	"PostScript":         {color: "#da291c"},
+	"PowerBuilder":       {color: "#8f0f8d"},
+	"PowerShell":         {icon: 0xf0a0a, color: "#012456"},
+	"Processing":         {color: "#0096D8"},
+	"Prolog":             {icon: 0xe7a1, color: "#74283c"},
+	"Pug":                {color: "#a86454"},
+	"Puppet":             {color: "#302B6D"},
+	"PureBasic":          {color: "#5a6986"},
+	"PureScript":         {icon: 0xe630, color: "#1D222D"},
+	"Python":             {icon: 0xe73c, color: "#3572A5"},
+	"Q#":                 {color: "#fed659"},
+	"QML":                {color: "#44a51c"},
+	"R":                  {icon: 0xf07d4, color: "#198CE7"},
+	"Racket":             {color: "#3c5caa"},
+	"Raku":               {color: "#0000fb"},
+	"Rascal":             {color: "#fffaa0"},
+	"ReScript":           {color: "#ed5051"},
+	"Reason":             {icon: 0xe687, color: "#ff5847"},
+	"Rebol":              {color: "#358a5b"},
+	"Red":                {color: "#f50000"},
+	"Ren'Py":             {color: "#ff7f7f"},
+	"Ring":               {color: "#2D54CB"},
+	"Riot":               {color: "#A71E49"},
+	"Roff":               {color: "#ecdebe"},
+	"Ruby":               {icon: 0xe739, color: "#701516"},
+	"Rust":               {icon: 0xe7a8, color: "#dea584"},
+	"SAS":                {color: "#B34936"},
+	"SCSS":               {icon: 0xe74b, color: "#c6538c"},
+	"Sass":               {icon: 0xe74b, color: "#a53b70"},
+	"Scala":              {icon: 0xe737, color: "#c22d40"},
+	"Scheme":             {color: "#1e4aec"},
+	"Self":               {color: "#0579aa"},
+	"Shell":              {icon: 0xe795, color: "#89e051"},
+	"Shen":               {color: "#120F14"},
+	"Slash":              {color: "#007eff"},
+	"Slim":               {color: "#2b2b2b"},
+	"Smalltalk":          {color: "#596706"},
+	"Smarty":             {color: "#f0c040"},
+	"Solidity":           {color: "#AA6746"},
+	"SourcePawn":         {color: "#f69e1d"},
+	"Squirrel":           {color: "#800000"},
+	"Stan":               {color: "#b2011d"},
+	"Standard ML":        {color: "#dc566d"},
+	"Starlark":           {color: "#76d275"},
+	"Stylus":             {color: "#ff6347"},
+	"SuperCollider":      {color: "#46390b"},
+	"Svelte":             {icon: 0xe697, color: "#ff3e00"},
+	"Swift":              {icon: 0xe755, color: "#F05138"},
+	"SystemVerilog":      {color: "#DAE1C2"},
+	"TSQL":               {color: "#e38c00"},
+	"TSX":                {icon: 0xe7ba, color: "#3178c6"},
+	"Tcl":                {color: "#e4cc98"},
+	"TeX":                {icon: 0xe69b, color: "#3D6117"},
+	"Terra":              {color: "#00004c"},
+	"Terraform Template": {color: "#7b42bb"},
+	"Thrift":             {color: "#D12127"},
+	"Turing":             {color: "#cf142b"},
+	"Twig":               {color: "#c1d026"},
+	"TypeScript":         {icon: 0xe628, color: "#3178c6"},
+	"Typst":              {color: "#239dad"},
+	"Uno":                {color: "#9933cc"},
+	"UnrealScript":       {color: "#a54c4d"},
+	"V":                  {color: "#4f87c4"},
+	"VHDL":               {color: "#adb2cb"},
+	"Vala":               {color: "#a56de2"},
+	"Verilog":            {color: "#b2b7f8"},
+	"Vim Script":         {icon: 0xe62b, color: "#199f4b"},
+	"Visual Basic .NET":  {color: "#945db7"},
+	"Volt":               {color: "#1F1F1F"},
+	"Vue":                {icon: 0xf0844, color: "#41b883"},
+	"Vyper":              {color: "#9F4CF2"},
+	"WGSL":               {color: "#1a5e9a"},
+	"WebAssembly":        {color: "#04133b"},
+	"Wollok":             {color: "#a23738"},
+	"X10":                {color: "#4B6BEF"},
+	"XQuery":             {color: "#5232e7"},
+	"XSLT":               {color: "#EB8CEB"},
+	"YAML":               {icon: 0xe6a8, color: "#cb171e"},
+	"YARA":               {color: "#220000"},
+	"Yacc":               {color: "#4B6C4B"},
+	"ZenScript":          {color: "#00BCD1"},
+	"Zephir":             {color: "#118f9e"},
+	"Zig":                {icon: 0xe6a9, color: "#ec915c"},
+	"Zimpl":              {color: "#d67711"},
+	"jq":                 {color: "#c7254e"},
+	"nesC":               {color: "#94B0C7"},
+	"ooc":                {color: "#b0b77e"},
+	"sed":                {color: "#64b970"},
+	"xBase":              {color: "#403a40"},
+}
+
+var aliases = map[string]string{
+	"abap":                             "ABAP",
+	"actionscript":                     "ActionScript",
+	"actionscript 3":                   "ActionScript",
+	"actionscript3":                    "ActionScript",
+	"ada":                              "Ada",
+	"ada2005":                          "Ada",
+	"ada95":                            "Ada",
+	"advpl":                            "xBase",
+	"agda":                             "Agda",
+	"ahk":                              "AutoHotkey",
+	"applescript":                      "AppleScript",
+	"as3":                              "ActionScript",
+	"asm":                              "Assembly",
+	"assembly":                         "Assembly",
+	"astro":                            "Astro",
+	"autohotkey":                       "AutoHotkey",
+	"awk":                              "Awk",
+	"ballerina":                        "Ballerina",
+	"bash":                             "Shell",
+	"bat":                              "Batchfile",
+	"batch":                            "Batchfile",
+	"batchfile":                        "Batchfile",
+	"bazel":                            "Starlark",
+	"bicep":                            "Bicep",
+	"blade":                            "Blade",
+	"bsdmake":                          "Makefile",
+	"bzl":                              "Starlark",
+	"c":                                "C",
+	"c#":                               "C#",
+	"c++":                              "C++",
+	"cairo":                            "Cairo",
+	"cake":                             "C#",
+	"cakescript":                       "C#",
+	"ceylon":                           "Ceylon",
+	"chapel":                           "Chapel",
+	"chpl":                             "Chapel",
+	"clipper":                          "xBase",
+	"clojure":                          "Clojure",
+	"cmake":                            "CMake",
+	"coffee":                           "CoffeeScript",
+	"coffee-script":                    "CoffeeScript",
+	"coffeescript":                     "CoffeeScript",
+	"common lisp":                      "Common Lisp",
+	"containerfile":                    "Dockerfile",
+	"cperl":                            "Perl",
+	"cpp":                              "C++",
+	"crystal":                          "Crystal",
+	"csharp":                           "C#",
+	"css":                              "CSS",
+	"cucumber":                         "Gherkin",
+	"cuda":                             "Cuda",
+	"d":                                "D",
+	"dart":                             "Dart",
+	"delphi":                           "Pascal",
+	"dhall":                            "Dhall",
+	"dlang":                            "D",
+	"dockerfile":                       "Dockerfile",
+	"dosbatch":                         "Batchfile",
+	"elisp":                            "Emacs Lisp",
+	"elixir":                           "Elixir",
+	"elm":                              "Elm",
+	"elvish":                           "Elvish",
+	"emacs":                            "Emacs Lisp",
+	"emacs lisp":                       "Emacs Lisp",
+	"envrc":                            "Shell",
+	"erlang":                           "Erlang",
+	"f#":                               "F#",
+	"fennel":                           "Fennel",
+	"flex":                             "Lex",
+	"fortran":                          "Fortran",
+	"foxpro":                           "xBase",
+	"fsharp":                           "F#",
+	"gdscript":                         "GDScript",
+	"gherkin":                          "Gherkin",
+	"gleam":                            "Gleam",
+	"glsl":                             "GLSL",
+	"go":                               "Go",
+	"golang":                           "Go",
+	"graphql":                          "GraphQL",
+	"groff":                            "Roff",
+	"groovy":                           "Groovy",
+	"hack":                             "Hack",
+	"haml":                             "Haml",
+	"handlebars":                       "Handlebars",
+	"hashicorp configuration language": "HCL",
+	"haskell":                          "Haskell",
+	"haxe":                             "Haxe",
+	"hbs":                              "Handlebars",
+	"hcl":                              "HCL",
+	"hlsl":                             "HLSL",
+	"html":                             "HTML",
+	"htmlbars":                         "Handlebars",
+	"hy":                               "Hy",
+	"hylang":                           "Hy",
+	"idris":                            "Idris",
+	"inc":                              "PHP",
+	"inno setup":                       "Inno Setup",
+	"io":                               "Io",
+	"ipython notebook":                 "Jupyter Notebook",
+	"j":                                "J",
+	"janet":                            "Janet",
+	"java":                             "Java",
+	"javascript":                       "JavaScript",
+	"jolie":                            "Jolie",
+	"jq":                               "jq",
+	"jruby":                            "Ruby",
+	"js":                               "JavaScript",
+	"jsonnet":                          "Jsonnet",
+	"julia":                            "Julia",
+	"jupyter notebook":                 "Jupyter Notebook",
+	"just":                             "Just",
+	"justfile":                         "Just",
+	"kak":                              "Kakoune Script",
+	"kakoune script":                   "Kakoune Script",
+	"kakscript":                        "Kakoune Script",
+	"kotlin":                           "Kotlin",
+	"latex":                            "TeX",
+	"less":                             "Less",
+	"less-css":                         "Less",
+	"lex":                              "Lex",
+	"lfe":                              "LFE",
+	"lhaskell":                         "Literate Haskell",
+	"lhs":                              "Literate Haskell",
+	"liquid":                           "Liquid",
+	"lisp":                             "Common Lisp",
+	"literate haskell":                 "Literate Haskell",
+	"live-script":                      "LiveScript",
+	"livescript":                       "LiveScript",
+	"llvm":                             "LLVM",
+	"lolcode":                          "LOLCODE",
+	"ls":                               "LiveScript",
+	"lua":                              "Lua",
+	"luau":                             "Luau",
+	"macruby":                          "Ruby",
+	"make":                             "Makefile",
+	"makefile":                         "Makefile",
+	"mako":                             "Mako",
+	"man":                              "Roff",
+	"man page":                         "Roff",
+	"man-page":                         "Roff",
+	"manpage":                          "Roff",
+	"markdown":                         "Markdown",
+	"mask":                             "Mask",
+	"matlab":                           "MATLAB",
+	"max":                              "Max",
+	"max/msp":                          "Max",
+	"maxmsp":                           "Max",
+	"md":                               "Markdown",
+	"mdoc":                             "Roff",
+	"mdx":                              "MDX",
+	"mercury":                          "Mercury",
+	"meson":                            "Meson",
+	"metal":                            "Metal",
+	"mf":                               "Makefile",
+	"modelica":                         "Modelica",
+	"mojo":                             "Mojo",
+	"moonscript":                       "MoonScript",
+	"move":                             "Move",
+	"nasm":                             "Assembly",
+	"nesc":                             "nesC",
+	"newlisp":                          "NewLisp",
+	"nextflow":                         "Nextflow",
+	"nim":                              "Nim",
+	"nit":                              "Nit",
+	"nix":                              "Nix",
+	"nixos":                            "Nix",
+	"njk":                              "Nunjucks",
+	"node":                             "JavaScript",
+	"nroff":                            "Roff",
+	"nu":                               "Nu",
+	"nu-script":                        "Nushell",
+	"nunjucks":                         "Nunjucks",
+	"nush":                             "Nu",
+	"nushell":                          "Nushell",
+	"nvim":                             "Vim Script",
+	"obj-c":                            "Objective-C",
+	"obj-c++":                          "Objective-C++",
+	"objc":                             "Objective-C",
+	"objc++":                           "Objective-C++",
+	"objective-c":                      "Objective-C",
+	"objective-c++":                    "Objective-C++",
+	"objectivec":                       "Objective-C",
+	"objectivec++":                     "Objective-C++",
+	"objectpascal":                     "Pascal",
+	"ocaml":                            "OCaml",
+	"octave":                           "MATLAB",
+	"odin":                             "Odin",
+	"odin-lang":                        "Odin",
+	"odinlang":                         "Odin",
+	"ooc":                              "ooc",
+	"opal":                             "Opal",
+	"openscad":                         "OpenSCAD",
+	"osascript":                        "AppleScript",
+	"oxygene":                          "Oxygene",
+	"oz":                               "Oz",
+	"p4":                               "P4",
+	"pandoc":                           "Markdown",
+	"papyrus":                          "Papyrus",
+	"parrot":                           "Parrot",
+	"pascal":                           "Pascal",
+	"pawn":                             "Pawn",
+	"perl":                             "Perl",
+	"perl-6":                           "Raku",
+	"perl6":                            "Raku",
+	"php":                              "PHP",
+	"piglatin":                         "PigLatin",
+	"plpgsql":                          "PLpgSQL",
+	"plsql":                            "PLSQL",
+	"pod6":                             "Roff",
+	"pogoscript":                       "PogoScript",
+	"posh":                             "PowerShell",
+	"postscr":                          "PostScript",
+	"postscript":                       "PostScript",
+	"powerbuilder":                     "PowerBuilder",
+	"powershell":                       "PowerShell",
+	"processing":                       "Processing",
+	"prolog":                           "Prolog",
+	"pug":                              "Pug",
+	"puppet":                           "Puppet",
+	"purebasic":                        "PureBasic",
+	"purescript":                       "PureScript",
+	"pwsh":                             "PowerShell",
+	"python":                           "Python",
+	"python3":                          "Python",
+	"q#":                               "Q#",
+	"qml":                              "QML",
+	"qsharp":                           "Q#",
+	"r":                                "R",
+	"racket":                           "Racket",
+	"rake":                             "Ruby",
+	"raku":                             "Raku",
+	"rascal":                           "Rascal",
+	"rb":                               "Ruby",
+	"rbx":                              "Ruby",
+	"reason":                           "Reason",
+	"rebol":                            "Rebol",
+	"red":                              "Red",
+	"red/system":                       "Red",
+	"ren'py":                           "Ren'Py",
+	"renpy":                            "Ren'Py",
+	"rescript":                         "ReScript",
+	"ring":                             "Ring",
+	"riot":                             "Riot",
+	"roff":                             "Roff",
+	"rs":                               "Rust",
+	"rscript":                          "R",
+	"ruby":                             "Ruby",
+	"rust":                             "Rust",
+	"rusthon":                          "Python",
+	"sas":                              "SAS",
+	"sass":                             "Sass",
+	"scala":                            "Scala",
+	"scheme":                           "Scheme",
+	"scss":                             "SCSS",
+	"sdc":                              "Tcl",
+	"sed":                              "sed",
+	"self":                             "Self",
+	"sh":                               "Shell",
+	"shell":                            "Shell",
+	"shell-script":                     "Shell",
+	"shen":                             "Shen",
+	"slash":                            "Slash",
+	"slim":                             "Slim",
+	"smalltalk":                        "Smalltalk",
+	"smarty":                           "Smarty",
+	"sml":                              "Standard ML",
+	"solidity":                         "Solidity",
+	"sourcemod":                        "SourcePawn",
+	"sourcepawn":                       "SourcePawn",
+	"splus":                            "R",
+	"squeak":                           "Smalltalk",
+	"squirrel":                         "Squirrel",
+	"stan":                             "Stan",
+	"standard ml":                      "Standard ML",
+	"starlark":                         "Starlark",
+	"stylus":                           "Stylus",
+	"supercollider":                    "SuperCollider",
+	"svelte":                           "Svelte",
+	"swift":                            "Swift",
+	"systemverilog":                    "SystemVerilog",
+	"tcl":                              "Tcl",
+	"terra":                            "Terra",
+	"terraform":                        "HCL",
+	"terraform template":               "Terraform Template",
+	"tex":                              "TeX",
+	"thrift":                           "Thrift",
+	"troff":                            "Roff",
+	"ts":                               "TypeScript",
+	"tsql":                             "TSQL",
+	"tsx":                              "TSX",
+	"turing":                           "Turing",
+	"twig":                             "Twig",
+	"typescript":                       "TypeScript",
+	"typst":                            "Typst",
+	"uno":                              "Uno",
+	"unrealscript":                     "UnrealScript",
+	"v":                                "V",
+	"vala":                             "Vala",
+	"vb .net":                          "Visual Basic .NET",
+	"vb.net":                           "Visual Basic .NET",
+	"vbnet":                            "Visual Basic .NET",
+	"verilog":                          "Verilog",
+	"vhdl":                             "VHDL",
+	"vim":                              "Vim Script",
+	"vim script":                       "Vim Script",
+	"viml":                             "Vim Script",
+	"vimscript":                        "Vim Script",
+	"visual basic .net":                "Visual Basic .NET",
+	"vlang":                            "V",
+	"volt":                             "Volt",
+	"vue":                              "Vue",
+	"vyper":                            "Vyper",
+	"wasm":                             "WebAssembly",
+	"wast":                             "WebAssembly",
+	"webassembly":                      "WebAssembly",
+	"wgsl":                             "WGSL",
+	"winbatch":                         "Batchfile",
+	"wollok":                           "Wollok",
+	"x10":                              "X10",
+	"xbase":                            "xBase",
+	"xdc":                              "Tcl",
+	"xhtml":                            "HTML",
+	"xquery":                           "XQuery",
+	"xsl":                              "XSLT",
+	"xslt":                             "XSLT",
+	"xten":                             "X10",
+	"yacc":                             "Yacc",
+	"yaml":                             "YAML",
+	"yara":                             "YARA",
+	"yml":                              "YAML",
+	"zenscript":                        "ZenScript",
+	"zephir":                           "Zephir",
+	"zig":                              "Zig",
+	"zimpl":                            "Zimpl",
+	"zsh":                              "Shell",
+}
@@ -1,30 +1,69 @@
 package icons
 
-type nerd struct {
-	m map[string]rune
-}
+type nerd struct{}
 
 func (i *nerd) Lang(val string) string {
-	if r, ok := i.m[val]; ok {
-		return string(r)
+	if _, lang, ok := lookup(val); ok && lang.icon != 0 {
+		return string(lang.icon)
 	}
 	return val
 }
 
 func Nerd() *nerd {
-	return &nerd{
-		m: map[string]rune{
-			// dev
-			"Rust":   rune(0xe7a8),
-			"Python": rune(0xe73c),
-
-			// seti
-			"JavaScript": rune(0xe60c),
-			"TypeScript": rune(0xe628),
-			"Go":         rune(0xe65e),
-			"C":          rune(0xe649),
-			"C++":        rune(0xf0646),
-			"Reason":     rune(0xe687),
-		},
+	return &nerd{}
+}
+
+var nerdStates = map[State]rune{
+	Archived: 0xf411, // nf-oct-archive
+	Fork:     0xf402, // nf-oct-repo_forked
+	Template: 0xf24d, // nf-fa-clone
+	Disabled: 0xf05e, // nf-fa-ban
+	Mirror:   0xf41a, // nf-oct-mirror
+}
+
+var nerdTopics = map[string]rune{
+	"cli":              0xe795,  // nf-dev-terminal
+	"tui":              0xf0379, // nf-md-monitor
+	"database":         0xf1c0,  // nf-fa-database
+	"machine-learning": 0xf09d1, // nf-md-brain
+	"ai":               0xf06a9, // nf-md-robot
+	"web":              0xf0ac,  // nf-fa-globe
+	"api":              0xf1e6,  // nf-fa-plug
+	"security":         0xf023,  // nf-fa-lock
+	"game":             0xf11b,  // nf-fa-gamepad
+	"docker":           0xe7b0,  // nf-dev-docker
+	"kubernetes":       0xf10fe, // nf-md-kubernetes
+	"linux":            0xf17c,  // nf-fa-linux
+	"macos":            0xf179,  // nf-fa-apple
+	"windows":          0xf17a,  // nf-fa-windows
+	"mobile":           0xf10b,  // nf-fa-mobile
+	"editor":           0xf040,  // nf-fa-pencil
+	"testing":          0xf0c3,  // nf-fa-flask
+	"documentation":    0xf02d,  // nf-fa-book
+	"self-hosted":      0xf233,  // nf-fa-server
+	"awesome":          0xf005,  // nf-fa-star
+	"compiler":         0xf085,  // nf-fa-cogs
+}
+
+// nf-oct-law
+const nerdLicense = 0xf495
+
+func (i *nerd) State(s State) string {
+	return string(nerdStates[s])
+}
+
+func (i *nerd) License(spdx string) string {
+	family := LicenseFamily(spdx)
+	if family == "" {
+		return ""
+	}
+	return string(rune(nerdLicense)) + " " + family
+}
+
+func (i *nerd) Topic(topic string) string {
+	kind, ok := TopicKind(topic)
+	if !ok {
+		return ""
 	}
+	return string(nerdTopics[kind])
 }
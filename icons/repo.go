@@ -0,0 +1,113 @@
+package icons
+
+import "strings"
+
+type State int
+
+const (
+	Archived State = iota
+	Fork
+	Template
+	Disabled
+	Mirror
+)
+
+func (s State) String() string {
+	switch s {
+	case Archived:
+		return "archived"
+	case Fork:
+		return "fork"
+	case Template:
+		return "template"
+	case Disabled:
+		return "disabled"
+	case Mirror:
+		return "mirror"
+	}
+	return "unknown"
+}
+
+// RepoIcon maps repo states, licenses and topics to short badges,
+// empty string means no badge
+type RepoIcon interface {
+	State(State) string
+	License(spdx string) string
+	Topic(string) string
+}
+
+// Set is full icon set used by list rows
+type Set interface {
+	LangIcon
+	RepoIcon
+}
+
+// LicenseFamily groups SPDX license ids, unknown ids are returned as is
+func LicenseFamily(spdx string) string {
+	id := strings.ToUpper(spdx)
+	switch {
+	case id == "" || id == "NOASSERTION" || id == "OTHER":
+		return ""
+	case id == "MIT" || id == "MIT-0" || id == "ISC":
+		return "MIT"
+	case strings.HasPrefix(id, "BSD-") || id == "0BSD":
+		return "BSD"
+	case strings.HasPrefix(id, "APACHE-"):
+		return "Apache"
+	case strings.HasPrefix(id, "LGPL-"):
+		return "LGPL"
+	case strings.HasPrefix(id, "GPL-") || strings.HasPrefix(id, "AGPL-"):
+		return "GPL"
+	case strings.HasPrefix(id, "MPL-"):
+		return "MPL"
+	case id == "UNLICENSE" || id == "CC0-1.0" || id == "WTFPL":
+		return "PD"
+	}
+	return spdx
+}
+
+// Common topics and their spellings seen on GitHub
+var topics = map[string]string{
+	"cli":                     "cli",
+	"command-line":            "cli",
+	"command-line-tool":       "cli",
+	"terminal":                "cli",
+	"tui":                     "tui",
+	"terminal-ui":             "tui",
+	"database":                "database",
+	"db":                      "database",
+	"sql":                     "database",
+	"machine-learning":        "machine-learning",
+	"deep-learning":           "machine-learning",
+	"ml":                      "machine-learning",
+	"ai":                      "ai",
+	"llm":                     "ai",
+	"artificial-intelligence": "ai",
+	"web":                     "web",
+	"api":                     "api",
+	"security":                "security",
+	"game":                    "game",
+	"gamedev":                 "game",
+	"docker":                  "docker",
+	"kubernetes":              "kubernetes",
+	"k8s":                     "kubernetes",
+	"linux":                   "linux",
+	"macos":                   "macos",
+	"windows":                 "windows",
+	"android":                 "mobile",
+	"ios":                     "mobile",
+	"editor":                  "editor",
+	"testing":                 "testing",
+	"documentation":           "documentation",
+	"self-hosted":             "self-hosted",
+	"selfhosted":              "self-hosted",
+	"awesome":                 "awesome",
+	"awesome-list":            "awesome",
+	"compiler":                "compiler",
+}
+
+// TopicKind returns common topic the given one is spelling of
+func TopicKind(topic string) (string, bool) {
+	kind, ok := topics[strings.ToLower(topic)]
+	return kind, ok
+}
@@ -0,0 +1,81 @@
+package icons
+
+import "testing"
+
+func TestLicenseFamily(t *testing.T) {
+	tests := []struct {
+		spdx     string
+		expected string
+	}{
+		{"MIT", "MIT"},
+		{"ISC", "MIT"},
+		{"BSD-3-Clause", "BSD"},
+		{"0BSD", "BSD"},
+		{"Apache-2.0", "Apache"},
+		{"GPL-3.0", "GPL"},
+		{"AGPL-3.0", "GPL"},
+		{"LGPL-2.1", "LGPL"},
+		{"MPL-2.0", "MPL"},
+		{"Unlicense", "PD"},
+		{"CC0-1.0", "PD"},
+		{"NOASSERTION", ""},
+		{"", ""},
+		{"EPL-2.0", "EPL-2.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spdx, func(t *testing.T) {
+			if got := LicenseFamily(tt.spdx); got != tt.expected {
+				t.Errorf("LicenseFamily(%q) = %q, want %q", tt.spdx, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRepoIcons(t *testing.T) {
+	tests := []struct {
+		set      string
+		name     string
+		result   func(Set) string
+		expected string
+	}{
+		{"nerd", "archived", func(s Set) string { return s.State(Archived) }, "\uf411"},
+		{"nerd", "license", func(s Set) string { return s.License("MIT") }, "\uf495 MIT"},
+		{"nerd", "no license", func(s Set) string { return s.License("NOASSERTION") }, ""},
+		{"nerd", "topic alias", func(s Set) string { return s.Topic("command-line") }, "\ue795"},
+		{"nerd", "unknown topic", func(s Set) string { return s.Topic("rust") }, ""},
+		{"emoji", "fork", func(s Set) string { return s.State(Fork) }, "🍴"},
+		{"emoji", "topic", func(s Set) string { return s.Topic("Database") }, "🗄"},
+		{"ascii", "template", func(s Set) string { return s.State(Template) }, "[tmpl]"},
+		{"ascii", "license", func(s Set) string { return s.License("GPL-3.0") }, "GPL"},
+		{"ascii", "topic", func(s Set) string { return s.Topic("deep-learning") }, "ml"},
+		{"color", "mirror", func(s Set) string { return s.State(Mirror) }, "[mirr]"},
+		{"none", "disabled", func(s Set) string { return s.State(Disabled) }, ""},
+		{"none", "license", func(s Set) string { return s.License("MIT") }, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.set+"/"+tt.name, func(t *testing.T) {
+			set, err := Get(tt.set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.result(set); got != tt.expected {
+				t.Errorf("got %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNerdTopics(t *testing.T) {
+	for topic, kind := range topics {
+		if nerdTopics[kind] == 0 || emojiTopics[kind] == "" || asciiTopics[kind] == "" {
+			t.Errorf("topic %q of kind %q has no badge in every set", topic, kind)
+		}
+	}
+	for kind, r := range nerdTopics {
+		if !isPrivateUse(r) {
+			t.Errorf("topic %q: icon %#x is not a valid Nerd Font rune", kind, r)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+)
+
+type Action string
+
+const (
+	Star       Action = "star"
+	Unstar     Action = "unstar"
+	CreateList Action = "create-list"
+	DeleteList Action = "delete-list"
+	SetLists   Action = "set-lists"
+
+	// finish marks entry written by Begin as done or failed
+	finish Action = "finish"
+)
+
+// Inverse returns action reverting a
+func (a Action) Inverse() Action {
+	switch a {
+	case Star:
+		return Unstar
+	case Unstar:
+		return Star
+	case CreateList:
+		return DeleteList
+	case DeleteList:
+		return CreateList
+	}
+	return a
+}
+
+type Entry struct {
+	ID        int       `json:"id"`
+	Action    Action    `json:"action"`
+	Repo      string    `json:"repo"`
+	StarredAt time.Time `json:"starred_at"`
+	Time      time.Time `json:"time"`
+	Command   string    `json:"command"`
+	Session   string    `json:"session"`
+	Undoes    int       `json:"undoes,omitempty"`
+
+	// Unconfirmed entry was written before its call and never finished,
+	// Failed holds error of the call
+	Unconfirmed bool   `json:"unconfirmed,omitempty"`
+	Failed      string `json:"failed,omitempty"`
+	Finishes    int    `json:"finishes,omitempty"`
+
+	// Star list actions
+	Node   string   `json:"node,omitempty"`
+	List   string   `json:"list,omitempty"`
+	ListID string   `json:"list_id,omitempty"`
+	Before []string `json:"before,omitempty"`
+	After  []string `json:"after,omitempty"`
+}
+
+// Journal is an append-only log of mutating calls stored as JSON lines.
+// Entries are never rewritten, undo appends an entry pointing to the
+// undone one. Begin and Finish wrap a call, so an interrupted call is
+// still journaled.
+type Journal struct {
+	filename string
+	command  string
+	session  string
+	// Last ID is read from file once
+	lastID int
+	loaded bool
+}
+
+func Open(filename, command string) *Journal {
+	return &Journal{
+		filename: filename,
+		command:  command,
+		session:  time.Now().Format(time.RFC3339Nano),
+	}
+}
+
+// Entries returns journal entries with finish marks applied
+func (j *Journal) Entries() ([]Entry, error) {
+	f, err := os.Open(j.filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []Entry
+	index := map[int]int{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry Entry
+		err := json.Unmarshal(scanner.Bytes(), &entry)
+		if err != nil {
+			return nil, err
+		}
+		if entry.Action == finish {
+			i, ok := index[entry.Finishes]
+			if ok {
+				entries[i].Unconfirmed = false
+				entries[i].Failed = entry.Failed
+			}
+			continue
+		}
+		index[entry.ID] = len(entries)
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// Record appends entry of a finished call
+func (j *Journal) Record(entry Entry) error {
+	_, err := j.write(entry)
+	return err
+}
+
+// Begin appends entry before its call and returns its ID. Entry stays
+// unconfirmed until Finish.
+func (j *Journal) Begin(entry Entry) (int, error) {
+	entry.Unconfirmed = true
+	return j.write(entry)
+}
+
+// Finish marks entry started by Begin as done, or failed if err is not nil
+func (j *Journal) Finish(id int, err error) error {
+	mark := Entry{Action: finish, Finishes: id}
+	if err != nil {
+		mark.Failed = err.Error()
+	}
+	return j.append(mark)
+}
+
+func (j *Journal) write(entry Entry) (int, error) {
+	if !j.loaded {
+		entries, err := j.Entries()
+		if err != nil {
+			return 0, err
+		}
+		for _, e := range entries {
+			j.lastID = max(j.lastID, e.ID)
+		}
+		j.loaded = true
+	}
+	entry.ID = j.lastID + 1
+	err := j.append(entry)
+	if err != nil {
+		return 0, err
+	}
+	j.lastID = entry.ID
+	return entry.ID, nil
+}
+
+func (j *Journal) append(entry Entry) error {
+	entry.Time = time.Now()
+	entry.Command = j.command
+	entry.Session = j.session
+
+	f, err := os.OpenFile(j.filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(entry)
+}
+
+// Undone returns IDs of entries reverted by later entries
+func Undone(entries []Entry) map[int]bool {
+	undone := map[int]bool{}
+	for _, e := range entries {
+		if e.Undoes != 0 && e.Failed == "" {
+			undone[e.Undoes] = true
+		}
+	}
+	return undone
+}
+
+// Pending returns entries which can still be undone in journal order,
+// failed calls changed nothing to undo
+func Pending(entries []Entry) []Entry {
+	undone := Undone(entries)
+	var pending []Entry
+	for _, e := range entries {
+		if e.Undoes == 0 && e.Failed == "" && !undone[e.ID] {
+			pending = append(pending, e)
+		}
+	}
+	return pending
+}
@@ -0,0 +1,129 @@
+package journal
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecord(t *testing.T) {
+	j := Open(filepath.Join(t.TempDir(), "journal.jsonl"), "test")
+	for _, e := range []Entry{
+		{Action: Unstar, Repo: "a/a"},
+		{Action: Unstar, Repo: "b/b"},
+		{Action: Star, Repo: "a/a", Undoes: 1},
+	} {
+		if err := j.Record(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := j.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+	for i, e := range entries {
+		if e.ID != i+1 {
+			t.Errorf("entries[%d].ID = %d, want %d", i, e.ID, i+1)
+		}
+		if e.Command != "test" {
+			t.Errorf("entries[%d].Command = %q, want %q", i, e.Command, "test")
+		}
+	}
+}
+
+func TestBeginFinish(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "journal.jsonl")
+	j := Open(filename, "test")
+	done, err := j.Begin(Entry{Action: Unstar, Repo: "a/a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed, _ := j.Begin(Entry{Action: Unstar, Repo: "b/b"})
+	interrupted, _ := j.Begin(Entry{Action: Unstar, Repo: "c/c"})
+	if err := j.Finish(done, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Finish(failed, errors.New("boom")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Next journal continues IDs of the file
+	next := Open(filename, "test")
+	if err := next.Record(Entry{Action: Star, Repo: "d/d"}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := next.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Entry{
+		{ID: done, Repo: "a/a"},
+		{ID: failed, Repo: "b/b", Failed: "boom"},
+		{ID: interrupted, Repo: "c/c", Unconfirmed: true},
+		{ID: 4, Repo: "d/d"},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(expected))
+	}
+	for i, e := range entries {
+		want := expected[i]
+		if e.ID != want.ID || e.Repo != want.Repo || e.Failed != want.Failed || e.Unconfirmed != want.Unconfirmed {
+			t.Errorf("entries[%d] = %+v, want %+v", i, e, want)
+		}
+	}
+}
+
+func TestPending(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  []Entry
+		expected []int
+	}{
+		{"empty", nil, nil},
+		{"all pending", []Entry{{ID: 1}, {ID: 2}}, []int{1, 2}},
+		{"undone", []Entry{{ID: 1}, {ID: 2}, {ID: 3, Undoes: 1}}, []int{2}},
+		{"undo of undo", []Entry{{ID: 1}, {ID: 2, Undoes: 1}, {ID: 3}}, []int{3}},
+		{"failed", []Entry{{ID: 1, Failed: "boom"}, {ID: 2}}, []int{2}},
+		{"failed undo", []Entry{{ID: 1}, {ID: 2, Undoes: 1, Failed: "boom"}}, []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pending := Pending(tt.entries)
+			if len(pending) != len(tt.expected) {
+				t.Fatalf("Pending() = %v, want IDs %v", pending, tt.expected)
+			}
+			for i, e := range pending {
+				if e.ID != tt.expected[i] {
+					t.Errorf("Pending()[%d].ID = %d, want %d", i, e.ID, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	tests := []struct {
+		action   Action
+		expected Action
+	}{
+		{Star, Unstar},
+		{Unstar, Star},
+		{CreateList, DeleteList},
+		{DeleteList, CreateList},
+		{SetLists, SetLists},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.action), func(t *testing.T) {
+			if result := tt.action.Inverse(); result != tt.expected {
+				t.Errorf("%q.Inverse() = %q, want %q", tt.action, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// keyMap holds bindings of every pane, config remaps them by action name
+type keyMap struct {
+	list    *listKeyMap
+	sel     *selectKeyMap
+	nav     *list.KeyMap
+	facets  *facetKeyMap
+	preview *previewKeyMap
+	actions *actionKeyMap
+	dialog  *dialogKeyMap
+	// Works in every pane
+	quit key.Binding
+}
+
+func newKeyMap() *keyMap {
+	nav := list.DefaultKeyMap()
+	// f and d are taken by facets and details
+	nav.NextPage.SetKeys("right", "l", "pgdown")
+	nav.NextPage.SetHelp("→/l/pgdn", "next page")
+	return &keyMap{
+		list:    newListKeyMap(),
+		sel:     newSelectKeyMap(),
+		nav:     &nav,
+		facets:  newFacetKeyMap(),
+		preview: newPreviewKeyMap(),
+		actions: newActionKeyMap(),
+		dialog:  newDialogKeyMap(),
+		quit: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+			key.WithHelp("ctrl+c", "quit"),
+		),
+	}
+}
+
+// keyAction is a binding remappable from config. Bindings of the same
+// scope are active at once, empty scope is active everywhere.
+type keyAction struct {
+	name    string
+	scope   string
+	binding *key.Binding
+}
+
+func (k *keyMap) bindings() []keyAction {
+	return []keyAction{
+		{"force_quit", "", &k.quit},
+
+		{"up", "list", &k.nav.CursorUp},
+		{"down", "list", &k.nav.CursorDown},
+		{"next_page", "list", &k.nav.NextPage},
+		{"prev_page", "list", &k.nav.PrevPage},
+		{"start", "list", &k.nav.GoToStart},
+		{"end", "list", &k.nav.GoToEnd},
+		{"filter", "list", &k.nav.Filter},
+		{"help", "list", &k.nav.ShowFullHelp},
+		{"quit", "list", &k.nav.Quit},
+		{"open", "list", &k.list.open},
+		{"show_archived", "list", &k.list.toggleShowArchived},
+		{"facets", "list", &k.list.toggleFacets},
+		{"details", "list", &k.list.toggleDetail},
+		{"compact", "list", &k.list.toggleCompact},
+		{"preview", "list", &k.list.showPreview},
+		{"actions", "list", &k.list.showActions},
+		{"copy_url", "list", &k.list.copyURL},
+		{"copy_name", "list", &k.list.copyName},
+		{"copy_ssh", "list", &k.list.copySSH},
+		{"copy_markdown", "list", &k.list.copyMarkdown},
+		{"unstar", "list", &k.list.unstar},
+		{"next_list", "list", &k.list.nextList},
+		{"team_filter", "list", &k.list.teamFilter},
+		{"add_tag", "list", &k.list.addTag},
+		{"remove_tag", "list", &k.list.removeTag},
+		{"edit_note", "list", &k.list.editNote},
+		{"mark", "list", &k.sel.mark},
+		{"visual", "list", &k.sel.visual},
+		{"mark_all", "list", &k.sel.markAll},
+		{"clear_marks", "list", &k.sel.clearMark},
+		{"bulk", "list", &k.sel.bulk},
+		{"clear_filter", "list", &k.nav.ClearFilter},
+
+		{"facet_up", "facets", &k.facets.up},
+		{"facet_down", "facets", &k.facets.down},
+		{"facet_toggle", "facets", &k.facets.toggle},
+		{"facet_mode", "facets", &k.facets.mode},
+		{"facet_clear", "facets", &k.facets.clear},
+		{"facet_leave", "facets", &k.facets.leave},
+
+		{"preview_close", "preview", &k.preview.close},
+
+		{"action_up", "actions", &k.actions.up},
+		{"action_down", "actions", &k.actions.down},
+		{"action_run", "actions", &k.actions.choose},
+		{"action_close", "actions", &k.actions.close},
+
+		{"confirm_yes", "confirm", &k.dialog.yes},
+		{"confirm_no", "confirm", &k.dialog.no},
+
+		// Other keys are typed into prompt
+		{"prompt_submit", "prompt", &k.dialog.submit},
+		{"prompt_cancel", "prompt", &k.dialog.cancel},
+	}
+}
+
+// keyPresets remap default bindings, user bindings are applied on top
+var keyPresets = map[string]map[string][]string{
+	"default": nil,
+	"vim": {
+		"next_page":     {"ctrl+f", "pgdown"},
+		"prev_page":     {"ctrl+b", "pgup"},
+		"start":         {"g", "home"},
+		"end":           {"G", "end"},
+		"quit":          {"q"},
+		"facet_leave":   {"esc", "q", "f"},
+		"preview_close": {"q", "esc", "p"},
+		"action_close":  {"q", "esc"},
+	},
+	"emacs": {
+		"up":            {"ctrl+p", "up"},
+		"down":          {"ctrl+n", "down"},
+		"next_page":     {"ctrl+v", "pgdown"},
+		"prev_page":     {"alt+v", "pgup"},
+		"start":         {"alt+<", "home"},
+		"end":           {"alt+>", "end"},
+		"filter":        {"ctrl+s", "/"},
+		"clear_filter":  {"ctrl+g", "esc"},
+		"quit":          {"q"},
+		"facet_up":      {"ctrl+p", "up"},
+		"facet_down":    {"ctrl+n", "down"},
+		"facet_leave":   {"ctrl+g", "esc", "tab"},
+		"preview_close": {"ctrl+g", "esc", "p"},
+		"action_up":     {"ctrl+p", "up"},
+		"action_down":   {"ctrl+n", "down"},
+		"action_close":  {"ctrl+g", "esc"},
+		"confirm_no":    {"ctrl+g", "n", "esc"},
+		"prompt_cancel": {"ctrl+g", "esc"},
+	},
+}
+
+func keyPresetNames() []string {
+	return slices.Sorted(maps.Keys(keyPresets))
+}
+
+// keyHelp formats keys for help view
+func keyHelp(keys []string) string {
+	names := make([]string, 0, 2)
+	for _, k := range keys[:min(len(keys), 2)] {
+		if k == " " {
+			k = "space"
+		}
+		names = append(names, k)
+	}
+	return strings.Join(names, "/")
+}
+
+// rebind sets keys of named actions, empty keys unbind action
+func (k *keyMap) rebind(bind map[string][]string) error {
+	actions := map[string]*key.Binding{}
+	for _, a := range k.bindings() {
+		actions[a.name] = a.binding
+	}
+	for _, name := range slices.Sorted(maps.Keys(bind)) {
+		b, ok := actions[name]
+		if !ok {
+			return fmt.Errorf("unknown key action %q", name)
+		}
+		keys := bind[name]
+		if len(keys) == 0 {
+			// Binding without keys is hidden from help
+			b.SetKeys()
+			continue
+		}
+		b.SetKeys(keys...)
+		b.SetHelp(keyHelp(keys), b.Help().Desc)
+	}
+	// Help is toggled by the same key, force quit works while filtering too
+	k.nav.CloseFullHelp.SetKeys(k.nav.ShowFullHelp.Keys()...)
+	k.nav.CloseFullHelp.SetHelp(k.nav.ShowFullHelp.Help().Key, k.nav.CloseFullHelp.Help().Desc)
+	k.nav.ForceQuit = k.quit
+	return nil
+}
+
+// keyOverlaps lists action pairs allowed to share keys. List itself clears
+// applied filter before quitting, so esc does both.
+var keyOverlaps = map[[2]string]bool{
+	{"quit", "clear_filter"}: true,
+}
+
+// conflict returns error for the first key bound to two actions active at once
+func (k *keyMap) conflict() error {
+	type bound struct {
+		name  string
+		scope string
+	}
+	seen := map[string][]bound{}
+	for _, a := range k.bindings() {
+		for _, key := range a.binding.Keys() {
+			for _, b := range seen[key] {
+				if keyOverlaps[[2]string{b.name, a.name}] {
+					continue
+				}
+				if b.scope == a.scope || b.scope == "" || a.scope == "" {
+					return fmt.Errorf("key %q is bound to both %s and %s", key, b.name, a.name)
+				}
+			}
+			seen[key] = append(seen[key], bound{a.name, a.scope})
+		}
+	}
+	return nil
+}
+
+// loadKeyMap builds keymap of preset remapped by user bindings
+func loadKeyMap(preset string, bind map[string][]string) (*keyMap, error) {
+	preset = cmp.Or(preset, "default")
+	base, ok := keyPresets[preset]
+	if !ok {
+		return nil, fmt.Errorf("unknown keymap %q, expected one of %s", preset, strings.Join(keyPresetNames(), ", "))
+	}
+	k := newKeyMap()
+	err := k.rebind(base)
+	if err != nil {
+		return nil, err
+	}
+	err = k.rebind(bind)
+	if err != nil {
+		return nil, err
+	}
+	err = k.conflict()
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// applyList sets navigation keys and help of list
+func (k *keyMap) applyList(l *list.Model) {
+	l.KeyMap = *k.nav
+	l.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			k.list.open,
+			k.list.toggleFacets,
+			k.list.showPreview,
+			k.list.showActions,
+		}
+	}
+	l.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			k.list.open,
+			k.list.toggleShowArchived,
+			k.list.toggleFacets,
+			k.list.toggleDetail,
+			k.list.toggleCompact,
+			k.list.showPreview,
+			k.list.showActions,
+			k.list.copyURL,
+			k.list.copyName,
+			k.list.copySSH,
+			k.list.copyMarkdown,
+			k.list.unstar,
+			k.list.nextList,
+			k.list.teamFilter,
+			k.list.addTag,
+			k.list.removeTag,
+			k.list.editNote,
+			k.sel.mark,
+			k.sel.visual,
+			k.sel.markAll,
+			k.sel.clearMark,
+			k.sel.bulk,
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestLoadKeyMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		preset  string
+		bind    map[string][]string
+		wantErr string
+	}{
+		{"default", "", nil, ""},
+		{"vim", "vim", nil, ""},
+		{"emacs", "emacs", nil, ""},
+		{"remap", "", map[string][]string{"unstar": {"D"}}, ""},
+		{"other scope", "", map[string][]string{"facet_clear": {"x"}}, ""},
+		{"unknown preset", "helix", nil, "unknown keymap"},
+		{"unknown action", "", map[string][]string{"fly": {"F"}}, "unknown key action"},
+		{"conflict", "", map[string][]string{"unstar": {"x"}}, `key "x" is bound to both unstar and clear_marks`},
+		{"force quit", "", map[string][]string{"preview_close": {"ctrl+c"}}, "force_quit and preview_close"},
+		{"preset conflict", "vim", map[string][]string{"mark": {"q"}}, "quit and mark"},
+		{"clear filter shares quit", "", map[string][]string{"clear_filter": {"q"}}, ""},
+		{"clear filter conflict", "", map[string][]string{"clear_filter": {"x"}}, `key "x" is bound to both clear_marks and clear_filter`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadKeyMap(tt.preset, tt.bind)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("loadKeyMap() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("loadKeyMap() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRebind(t *testing.T) {
+	k, err := loadKeyMap("", map[string][]string{
+		"unstar": {"D", "delete"},
+		"help":   {"H"},
+		"bulk":   {},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")}
+	if !key.Matches(d, k.list.unstar) {
+		t.Errorf("unstar does not match D")
+	}
+	if got := k.list.unstar.Help(); got.Key != "D/delete" || got.Desc != "unstar" {
+		t.Errorf("unstar help = %+v", got)
+	}
+	if keys := k.nav.CloseFullHelp.Keys(); len(keys) != 1 || keys[0] != "H" {
+		t.Errorf("close help keys = %v, want [H]", keys)
+	}
+	if k.sel.bulk.Enabled() {
+		t.Errorf("bulk is enabled without keys")
+	}
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/annotations"
+	"github.com/tmshv/ghstars/github"
+)
+
+const listSyncFile = ".gh_lists_sync.json"
+
+// Memberships maps repo ID to sorted names of its tags or lists
+type memberships map[int][]string
+
+type syncChange struct {
+	id     int
+	repo   string
+	add    []string
+	remove []string
+	from   []string
+	target []string
+}
+
+type syncConflict struct {
+	id     int
+	repo   string
+	local  []string
+	remote []string
+}
+
+type syncPlan struct {
+	create    []string
+	remote    []syncChange
+	local     []syncChange
+	conflicts []syncConflict
+	state     memberships
+}
+
+// changed reports whether plan has anything to apply
+func (p *syncPlan) changed() bool {
+	return len(p.create) > 0 || len(p.remote) > 0 || len(p.local) > 0
+}
+
+func (p *syncPlan) empty() bool {
+	return !p.changed() && len(p.conflicts) == 0
+}
+
+// conflictErr returns error telling how to resolve conflicts left untouched
+func (p *syncPlan) conflictErr() error {
+	if len(p.conflicts) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d conflicts left untouched, rerun with --prefer local or --prefer remote", len(p.conflicts))
+}
+
+func diffNames(from, to []string) (add, remove []string) {
+	for _, name := range to {
+		if !slices.Contains(from, name) {
+			add = append(add, name)
+		}
+	}
+	for _, name := range from {
+		if !slices.Contains(to, name) {
+			remove = append(remove, name)
+		}
+	}
+	return add, remove
+}
+
+func newSyncChange(id int, repo string, from, to []string) syncChange {
+	add, remove := diffNames(from, to)
+	return syncChange{id: id, repo: repo, add: add, remove: remove, from: from, target: to}
+}
+
+// planSync reconciles local tags with remote star lists against state of the
+// last sync. Direction is both, push (local wins) or pull (remote wins).
+// A repo changed on both sides is a conflict resolved by prefer
+// (local or remote) or left untouched when prefer is empty.
+func planSync(local, remote, base memberships, repos map[int]string, lists []string, direction, prefer string) syncPlan {
+	plan := syncPlan{state: memberships{}}
+	for id, names := range base {
+		plan.state[id] = names
+	}
+
+	ids := make([]int, 0, len(repos))
+	for id := range repos {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	created := map[string]bool{}
+	push := func(id int) {
+		plan.remote = append(plan.remote, newSyncChange(id, repos[id], remote[id], local[id]))
+		plan.state[id] = local[id]
+		for _, name := range local[id] {
+			if !slices.Contains(lists, name) && !created[name] {
+				created[name] = true
+				plan.create = append(plan.create, name)
+			}
+		}
+	}
+	pull := func(id int) {
+		plan.local = append(plan.local, newSyncChange(id, repos[id], local[id], remote[id]))
+		plan.state[id] = remote[id]
+	}
+
+	for _, id := range ids {
+		l, r := local[id], remote[id]
+		if slices.Equal(l, r) {
+			plan.state[id] = l
+			continue
+		}
+		b, synced := base[id]
+		switch {
+		case direction == "push":
+			push(id)
+		case direction == "pull":
+			pull(id)
+		case synced && slices.Equal(r, b):
+			push(id)
+		case synced && slices.Equal(l, b):
+			pull(id)
+		case prefer == "local":
+			push(id)
+		case prefer == "remote":
+			pull(id)
+		default:
+			plan.conflicts = append(plan.conflicts, syncConflict{id: id, repo: repos[id], local: l, remote: r})
+		}
+	}
+	sort.Strings(plan.create)
+	return plan
+}
+
+func printSyncPlan(plan syncPlan) {
+	for _, name := range plan.create {
+		fmt.Printf("+ create list %s\n", name)
+	}
+	show := func(side string, changes []syncChange) {
+		for _, c := range changes {
+			for _, name := range c.add {
+				fmt.Printf("+ %s: add %s to %s\n", side, c.repo, name)
+			}
+			for _, name := range c.remove {
+				fmt.Printf("- %s: remove %s from %s\n", side, c.repo, name)
+			}
+		}
+	}
+	show("github", plan.remote)
+	show("local", plan.local)
+	for _, c := range plan.conflicts {
+		fmt.Printf("! conflict %s: local [%s], github [%s]\n", c.repo, strings.Join(c.local, ", "), strings.Join(c.remote, ", "))
+	}
+}
+
+func loadSyncState() (memberships, error) {
+	state := memberships{}
+	data, err := os.ReadFile(listSyncFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &state)
+	return state, err
+}
+
+func saveSyncState(state memberships) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(listSyncFile, data, 0644)
+}
+
+func sortedNames(names []string) []string {
+	names = slices.Clone(names)
+	sort.Strings(names)
+	return names
+}
+
+func applySyncPlan(gh *github.Github, store *annotations.Store, plan syncPlan, lists []github.StarList, nodes map[int]string) error {
+	ids := map[string]string{}
+	for _, list := range lists {
+		ids[list.Name] = list.ID
+	}
+	for _, name := range plan.create {
+		list, err := gh.CreateList(name)
+		if err != nil {
+			return err
+		}
+		ids[list.Name] = list.ID
+		fmt.Printf("created list %s\n", name)
+	}
+
+	listIDs := func(names []string) []string {
+		var res []string
+		for _, name := range names {
+			res = append(res, ids[name])
+		}
+		return res
+	}
+	for _, c := range plan.remote {
+		err := gh.SetRepoLists(c.repo, nodes[c.id], listIDs(c.from), listIDs(c.target))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("updated lists of %s\n", c.repo)
+	}
+
+	for _, c := range plan.local {
+		for _, name := range c.add {
+			store.AddTag(c.id, c.repo, name)
+		}
+		for _, name := range c.remove {
+			store.RemoveTag(c.id, name)
+		}
+	}
+	err := store.Save()
+	if err != nil {
+		return err
+	}
+	return saveSyncState(plan.state)
+}
+
+func listsSyncCommand() *cobra.Command {
+	var direction, prefer string
+	var dryRun, yes bool
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Reconcile local tags with GitHub star lists",
+		Long: `sync makes every local tag a GitHub star list with the same name and the other way around.
+Changes made on one side since the last sync are applied to the other side.
+A repo changed on both sides is a conflict, use --prefer to resolve it.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			username, err := singleUser()
+			if err != nil {
+				return err
+			}
+			if !slices.Contains([]string{"both", "push", "pull"}, direction) {
+				return fmt.Errorf("bad direction %q, expected both, push or pull", direction)
+			}
+			if !slices.Contains([]string{"", "local", "remote"}, prefer) {
+				return fmt.Errorf("bad prefer %q, expected local or remote", prefer)
+			}
+
+			gh := newGithub("lists sync")
+			// Sync pushes the difference with GitHub, stale cache would revert changes made there
+			gh.UseCache(false)
+			store, err := annotations.Load(annotationsFile)
+			if err != nil {
+				return err
+			}
+			base, err := loadSyncState()
+			if err != nil {
+				return err
+			}
+			lists, err := gh.GetLists(username)
+			if err != nil {
+				return err
+			}
+
+			// Only starred repos can be synced, node ID is needed to update lists
+			repos := map[int]string{}
+			nodes := map[int]string{}
+			for res := range gh.GetStars(username) {
+				star, err := res.Unwrap()
+				if err != nil {
+					return err
+				}
+				repos[star.Repo.ID] = star.Repo.FullName
+				nodes[star.Repo.ID] = star.Repo.NodeID
+			}
+
+			local := memberships{}
+			for id, a := range store.All() {
+				if _, ok := repos[id]; ok && len(a.Tags) > 0 {
+					local[id] = sortedNames(a.Tags)
+				}
+			}
+			remote := memberships{}
+			existing := make([]string, 0, len(lists))
+			for _, list := range lists {
+				existing = append(existing, list.Name)
+			}
+			for id, names := range listNames(lists) {
+				if _, ok := repos[id]; ok {
+					remote[id] = sortedNames(names)
+				}
+			}
+
+			plan := planSync(local, remote, base, repos, existing, direction, prefer)
+			printSyncPlan(plan)
+			if plan.empty() {
+				fmt.Println("nothing to sync")
+				return nil
+			}
+			if dryRun || !plan.changed() {
+				return plan.conflictErr()
+			}
+			if !yes {
+				fmt.Print("apply? [y/N] ")
+				line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+				if strings.TrimSpace(strings.ToLower(line)) != "y" {
+					return nil
+				}
+			}
+			err = applySyncPlan(gh, store, plan, lists, nodes)
+			if err != nil {
+				return err
+			}
+			return plan.conflictErr()
+		},
+	}
+	cmd.Flags().StringVar(&direction, "direction", "both", "Sync direction: both, push (local to GitHub) or pull (GitHub to local)")
+	cmd.Flags().StringVar(&prefer, "prefer", "", "Resolve conflicts with local or remote side")
+	cmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Print what would change without changing it")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Apply changes without asking")
+	return cmd
+}
+
+func listsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lists",
+		Short: "Work with GitHub star lists",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "ls",
+		Short: "Show star lists of user",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			username, err := singleUser()
+			if err != nil {
+				return err
+			}
+			lists, err := newGithub(cmd.Name()).GetLists(username)
+			if err != nil {
+				return err
+			}
+			for _, list := range lists {
+				fmt.Printf("%s\t%d\t%s\n", list.Name, len(list.Repos), list.Description)
+			}
+			return nil
+		},
+	})
+	cmd.AddCommand(listsSyncCommand())
+	return cmd
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlanSync(t *testing.T) {
+	repos := map[int]string{1: "a/a", 2: "b/b"}
+	tests := []struct {
+		name      string
+		local     memberships
+		remote    memberships
+		base      memberships
+		direction string
+		prefer    string
+		create    []string
+		remote1   []string // lists of repo 1 pushed to GitHub
+		local1    []string // tags of repo 1 pulled from GitHub
+		conflicts int
+		empty     bool
+	}{
+		{
+			name:      "in sync",
+			local:     memberships{1: {"go"}},
+			remote:    memberships{1: {"go"}},
+			base:      memberships{},
+			direction: "both",
+			empty:     true,
+		},
+		{
+			name:      "local changed",
+			local:     memberships{1: {"cli", "go"}},
+			remote:    memberships{1: {"go"}},
+			base:      memberships{1: {"go"}},
+			direction: "both",
+			create:    []string{"cli"},
+			remote1:   []string{"cli", "go"},
+		},
+		{
+			name:      "remote changed",
+			local:     memberships{1: {"go"}},
+			remote:    memberships{1: {}},
+			base:      memberships{1: {"go"}},
+			direction: "both",
+			local1:    []string{},
+		},
+		{
+			name:      "both changed",
+			local:     memberships{1: {"cli"}},
+			remote:    memberships{1: {"go", "tui"}},
+			base:      memberships{1: {"go"}},
+			direction: "both",
+			conflicts: 1,
+		},
+		{
+			name:      "both changed prefer remote",
+			local:     memberships{1: {"cli"}},
+			remote:    memberships{1: {"go", "tui"}},
+			base:      memberships{1: {"go"}},
+			direction: "both",
+			prefer:    "remote",
+			local1:    []string{"go", "tui"},
+		},
+		{
+			name:      "never synced is conflict",
+			local:     memberships{1: {"cli"}},
+			remote:    memberships{1: {"go"}},
+			base:      memberships{},
+			direction: "both",
+			conflicts: 1,
+		},
+		{
+			name:      "push ignores base",
+			local:     memberships{1: {"go"}},
+			remote:    memberships{1: {"go", "tui"}},
+			base:      memberships{1: {"go", "tui"}},
+			direction: "push",
+			remote1:   []string{"go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := planSync(tt.local, tt.remote, tt.base, repos, []string{"go", "tui"}, tt.direction, tt.prefer)
+			if !slices.Equal(plan.create, tt.create) {
+				t.Errorf("create = %v, want %v", plan.create, tt.create)
+			}
+			if len(plan.conflicts) != tt.conflicts {
+				t.Errorf("conflicts = %v, want %d", plan.conflicts, tt.conflicts)
+			}
+			if plan.empty() != tt.empty {
+				t.Errorf("empty() = %v, want %v", plan.empty(), tt.empty)
+			}
+			if (plan.conflictErr() != nil) != (tt.conflicts > 0) {
+				t.Errorf("conflictErr() = %v with %d conflicts", plan.conflictErr(), tt.conflicts)
+			}
+			check := func(side string, changes []syncChange, expected []string) {
+				if expected == nil {
+					if len(changes) != 0 {
+						t.Errorf("%s changes = %v, want none", side, changes)
+					}
+					return
+				}
+				if len(changes) != 1 || changes[0].id != 1 || !slices.Equal(changes[0].target, expected) {
+					t.Errorf("%s changes = %v, want repo 1 to get %v", side, changes, expected)
+				}
+			}
+			check("remote", plan.remote, tt.remote1)
+			check("local", plan.local, tt.local1)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"log"
-	"os/exec"
 	"strings"
 	"time"
 
@@ -13,7 +12,12 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/annotations"
+	"github.com/tmshv/ghstars/github"
 	"github.com/tmshv/ghstars/icons"
+	"github.com/tmshv/ghstars/journal"
+	"github.com/tmshv/ghstars/set"
+	"github.com/tmshv/ghstars/theme"
 )
 
 type (
@@ -21,77 +25,322 @@ type (
 )
 
 type repoitem struct {
-	url      string
-	title    string
-	desc     string
-	tags     []string
-	lang     string
-	archived bool
+	url       string
+	title     string
+	tags      []string
+	lang      string
+	license   string
+	archived  bool
+	localTags []string
+	note      string
+	starredBy []string
+	star      *github.GhStarV3
+}
+
+func newRepoItem(star *github.GhStarV3, ann *annotations.Annotation, starredBy []string) repoitem {
+	i := repoitem{
+		url:       star.Repo.HTMLURL,
+		title:     star.Repo.HTMLURL,
+		lang:      star.Repo.Language,
+		tags:      star.Repo.Topics,
+		license:   star.Repo.License.SpdxID,
+		archived:  star.Repo.Archived,
+		starredBy: starredBy,
+		star:      star,
+	}
+	if ann != nil {
+		i.localTags = ann.Tags
+		i.note = ann.Note
+	}
+	return i
+}
+
+func (i repoitem) URL() string   { return i.url }
+func (i repoitem) Title() string { return i.title }
+
+// filterFields are joined into FilterValue in this order,
+// delegate relies on it to highlight matches of every column
+func (i repoitem) filterFields() []string {
+	fields := []string{i.url, i.star.Repo.Description, i.lang}
+	fields = append(fields, i.tags...)
+	fields = append(fields, i.localTags...)
+	fields = append(fields, i.note)
+	fields = append(fields, i.starredBy...)
+	return fields
 }
 
-func (i repoitem) URL() string         { return i.url }
-func (i repoitem) Title() string       { return i.title }
-func (i repoitem) Description() string { return i.desc }
 func (i repoitem) FilterValue() string {
-	var blocks []string
-	blocks = append(blocks, i.url)
-	blocks = append(blocks, i.desc)
-	blocks = append(blocks, i.lang)
-	blocks = append(blocks, i.tags...)
-	return strings.Join(blocks, " ")
+	return strings.Join(i.filterFields(), " ")
 }
 
 type model struct {
-	username     string
+	usernames    []string
+	teamFilter   teamFilter
+	fetching     int
+	gh           *github.Github
 	items        []repoitem
+	index        map[int]int
 	showArchived bool
 	textInput    textinput.Model
 	list         list.Model
-	keys         *listKeyMap
+	facets       facetPanel
+	collections  collections
+	initialList  string
+	sort         sortOrder
+	detail       detailMode
+	detailWidth  int
+	compact      bool
+	preview      preview
+	actions      actionMenu
+	confirm      confirmDialog
+	prompt       promptDialog
+	sel          *selection
+	notes        *annotations.Store
+	keys         *keyMap
+	width        int
+	height       int
 	err          error
 }
 
+func (m *model) keep(item repoitem) bool {
+	if !m.showArchived && item.archived {
+		return false
+	}
+	if !m.collections.match(item) {
+		return false
+	}
+	if !m.teamFilter.match(item, len(m.usernames)) {
+		return false
+	}
+	return m.facets.match(item)
+}
+
 func (m *model) getItems() []list.Item {
 	items := make([]list.Item, 0, len(m.items))
 	for _, item := range m.items {
-		if m.showArchived || !item.archived {
+		if m.keep(item) {
 			items = append(items, item)
 		}
 	}
+	m.sort.sort(items)
 	return items
 }
 
-var (
+// markedItems returns marked repos in list order
+func (m *model) markedItems() []repoitem {
+	var items []repoitem
+	for _, item := range m.items {
+		if m.sel.marked.Has(item.star.Repo.ID) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
 
-	titleStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFDF5")).
-			Background(lipgloss.Color("#25A065")).
-			Padding(0, 1)
+// annotate applies fn to annotations of items, saves them and refreshes the list
+func (m *model) annotate(items []repoitem, status string, fn func(id int, repo string)) tea.Cmd {
+	changed := set.New[int]()
+	for _, item := range items {
+		fn(item.star.Repo.ID, item.star.Repo.FullName)
+		changed.Add(item.star.Repo.ID)
+	}
+	err := m.notes.Save()
 
-	docStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("63"))
-)
+	for i, item := range m.items {
+		if changed.Has(item.star.Repo.ID) {
+			m.items[i] = m.newItem(item.star, item.starredBy)
+		}
+	}
+	cmd := m.list.SetItems(m.getItems())
+	return tea.Batch(cmd, func() tea.Msg {
+		return ActionDoneMsg{status: status, err: err}
+	})
+}
+
+func (m *model) addTag(items []repoitem, tag string) tea.Cmd {
+	if tag == "" {
+		return nil
+	}
+	return m.annotate(items, fmt.Sprintf("Tagged %d repos with %s", len(items), tag), func(id int, repo string) {
+		m.notes.AddTag(id, repo, tag)
+	})
+}
+
+func (m *model) removeTag(items []repoitem, tag string) tea.Cmd {
+	if tag == "" {
+		return nil
+	}
+	return m.annotate(items, fmt.Sprintf("Removed tag %s from %d repos", tag, len(items)), func(id int, repo string) {
+		m.notes.RemoveTag(id, tag)
+	})
+}
+
+func (m *model) setNote(items []repoitem, note string) tea.Cmd {
+	return m.annotate(items, "Note saved", func(id int, repo string) {
+		m.notes.SetNote(id, repo, note)
+	})
+}
+
+func (m *model) removeItems(ids []int) tea.Cmd {
+	removed := set.New[int]()
+	for _, id := range ids {
+		removed.Add(id)
+		m.sel.marked.Del(id)
+	}
+	items := m.items[:0]
+	m.index = map[int]int{}
+	m.facets.counts = set.NewCounter[facet]()
+	for _, item := range m.items {
+		if removed.Has(item.star.Repo.ID) {
+			continue
+		}
+		m.index[item.star.Repo.ID] = len(items)
+		items = append(items, item)
+		m.facets.add(item)
+	}
+	m.items = items
+	return m.list.SetItems(m.getItems())
+}
+
+func (m *model) showDetail() bool {
+	switch m.detail {
+	case detailOn:
+		return true
+	case detailOff:
+		return false
+	}
+	return m.width >= detailAutoWidth
+}
+
+// resize fits the list into the space left by the side panes and chips
+func (m *model) resize() {
+	h, v := docStyle.GetFrameSize()
+	width := m.width - h
+	height := m.height - v
+	if m.facets.visible {
+		width -= lipgloss.Width(m.facets.View())
+	}
+	if m.preview.visible {
+		previewWidth := width / 2
+		m.preview.setSize(previewWidth, height)
+		width -= previewWidth
+	} else if m.showDetail() {
+		m.detailWidth = detailWidth(width)
+		width -= m.detailWidth
+	}
+	if chips := m.facets.chips(); chips != "" {
+		height -= lipgloss.Height(chips)
+	}
+	m.facets.height = m.height
+	m.list.SetSize(width, height)
+}
 
 var (
-	username string
-	useCache bool
+	usernames  []string
+	teamFile   string
+	useCache   bool
+	cacheTTL   time.Duration
+	listName   string
+	iconName   string
+	themeName  string
+	sortName   string
+	keymapName string
 )
 
 var (
-	langIcon icons.LangIcon = icons.Nerd()
+	iconSet icons.Set = icons.Nerd()
 )
 
 type listKeyMap struct {
+	open               key.Binding
 	toggleShowArchived key.Binding
+	toggleFacets       key.Binding
+	toggleDetail       key.Binding
+	toggleCompact      key.Binding
+	showPreview        key.Binding
+	showActions        key.Binding
+	copyURL            key.Binding
+	copyName           key.Binding
+	copySSH            key.Binding
+	copyMarkdown       key.Binding
+	unstar             key.Binding
+	nextList           key.Binding
+	teamFilter         key.Binding
+	addTag             key.Binding
+	removeTag          key.Binding
+	editNote           key.Binding
 }
 
 func newListKeyMap() *listKeyMap {
 	return &listKeyMap{
+		open: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "open in browser"),
+		),
 		toggleShowArchived: key.NewBinding(
 			key.WithKeys("a"),
 			key.WithHelp("a", "show archived"),
 		),
+		toggleFacets: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp("f", "facets"),
+		),
+		toggleDetail: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "details"),
+		),
+		toggleCompact: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "compact rows"),
+		),
+		showPreview: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "readme"),
+		),
+		showActions: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "actions"),
+		),
+		copyURL: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "copy url"),
+		),
+		copyName: key.NewBinding(
+			key.WithKeys("Y"),
+			key.WithHelp("Y", "copy owner/name"),
+		),
+		copySSH: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "copy ssh url"),
+		),
+		copyMarkdown: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "copy markdown link"),
+		),
+		unstar: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "unstar"),
+		),
+		nextList: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "next star list"),
+		),
+		teamFilter: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "starred by everyone/one"),
+		),
+		addTag: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "add tag"),
+		),
+		removeTag: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "remove tag"),
+		),
+		editNote: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "edit note"),
+		),
 	}
 }
 
@@ -100,71 +349,50 @@ func monthsPassed(t time.Time) int {
 	return int(time.Since(t).Hours() / 24 / 30)
 }
 
-func OpenURL(url string) error {
-	cmd := exec.Command("open", url) // Use "xdg-open" on Linux or "start" on Windows
-	err := cmd.Start()
-	if err != nil {
-		return err
-	}
-
-	// Wait for the command to finish executing
-	err = cmd.Wait()
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func initialModel(username string) model {
-	var style = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FAFAFA")).
-		Background(lipgloss.Color("#7D56F4"))
-		// Bold(true).
-		// PaddingTop(2).
-		// PaddingLeft(4).
-		// Width(22)
-
+func initialModel(gh *github.Github, notes *annotations.Store, usernames []string, initialList string, keys *keyMap) model {
 	ti := textinput.New()
 	ti.Placeholder = "Query"
-	ti.TextStyle = style
-	ti.PlaceholderStyle = lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#BABABA")).
-		Background(lipgloss.Color("#7D56F4"))
+	ti.TextStyle = inputStyle
+	ti.PlaceholderStyle = placeholderStyle
 	ti.Focus()
 	ti.CharLimit = 156
 	ti.Width = 20
 
-	var (
-		listKeys = newListKeyMap()
-	)
-
-	listdelegate := list.NewDefaultDelegate()
-	listdelegate.ShowDescription = true
-	listdelegate.SetHeight(3)
-	l := list.New([]list.Item{}, listdelegate, 0, 0)
+	sel := newSelection()
+	l := list.New([]list.Item{}, newRepoDelegate(sel, false, len(usernames)), 0, 0)
 	// l.SetFilteringEnabled(false)
-	l.Title = fmt.Sprintf("%s's Stars", username)
-	l.Styles.Title = titleStyle
-	l.AdditionalFullHelpKeys = func() []key.Binding {
-		return []key.Binding{
-			listKeys.toggleShowArchived,
-		}
-	}
-
-	return model{
-		username:  username,
-		textInput: ti,
-		list:      l,
-		keys:      listKeys,
-		err:       nil,
+	l.Styles = listTheme
+	l.Help.Styles = helpStyles
+	keys.applyList(&l)
+
+	m := model{
+		usernames:   usernames,
+		fetching:    len(usernames),
+		index:       map[int]int{},
+		gh:          gh,
+		textInput:   ti,
+		list:        l,
+		facets:      newFacetPanel(keys.facets),
+		collections: newCollections(),
+		initialList: initialList,
+		preview:     newPreview(keys.preview),
+		actions:     newActionMenu(keys.actions),
+		confirm:     newConfirmDialog(keys.dialog),
+		prompt:      newPromptDialog(),
+		sel:         sel,
+		notes:       notes,
+		keys:        keys,
+		err:         nil,
 	}
+	m.list.Title = m.title()
+	return m
 }
 
 func (m model) Init() tea.Cmd {
 	return tea.Batch(
 		textinput.Blink,
 		GhStartFetch,
+		GhFetchLists(m.gh, m.usernames[0]),
 	)
 }
 
@@ -178,60 +406,205 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			break
 		}
 
+		if key.Matches(msg, m.keys.quit) {
+			return m, tea.Quit
+		}
+		if m.prompt.visible {
+			return m.updatePrompt(msg)
+		}
+		if m.confirm.visible {
+			return m.updateConfirm(msg)
+		}
+		if m.actions.visible {
+			act := m.actions.update(msg)
+			if act == nil {
+				return m, nil
+			}
+			if act.confirm {
+				m.confirm.open(act, m.actions.items)
+				return m, nil
+			}
+			cmd := act.run(&m, m.actions.items)
+			return m, cmd
+		}
+		if m.facets.focused {
+			return m.updateFacets(msg)
+		}
+		if m.preview.visible {
+			return m.updatePreview(msg)
+		}
+
 		switch {
-		case key.Matches(msg, m.keys.toggleShowArchived):
+		case key.Matches(msg, m.keys.list.toggleShowArchived):
 			m.showArchived = !m.showArchived
 			cmd := m.list.SetItems(m.getItems())
 			return m, cmd
-		}
-
-		switch msg.Type {
-		case tea.KeyCtrlC:
-			return m, tea.Quit
-		case tea.KeyEnter:
+		case key.Matches(msg, m.keys.list.toggleFacets):
+			m.facets.visible = true
+			m.facets.focused = true
+			m.resize()
+			return m, nil
+		case key.Matches(msg, m.keys.list.toggleDetail):
+			if m.showDetail() {
+				m.detail = detailOff
+			} else {
+				m.detail = detailOn
+			}
+			m.resize()
+			return m, nil
+		case key.Matches(msg, m.keys.list.toggleCompact):
+			m.compact = !m.compact
+			m.list.SetDelegate(newRepoDelegate(m.sel, m.compact, len(m.usernames)))
+			return m, nil
+		case key.Matches(msg, m.keys.list.showPreview):
+			val, ok := m.list.SelectedItem().(repoitem)
+			if !ok {
+				return m, nil
+			}
+			cmd := m.preview.open(m.gh, val.star)
+			m.resize()
+			return m, cmd
+		case key.Matches(msg, m.keys.list.showActions):
 			val, ok := m.list.SelectedItem().(repoitem)
 			if ok {
-				err := OpenURL(val.URL())
-				if err != nil {
-					return m, tea.Quit
-				}
+				m.actions.open(val.title, []repoitem{val}, repoActions)
 			}
-			return m, tea.Quit
+			return m, nil
+		case key.Matches(msg, m.keys.list.unstar):
+			val, ok := m.list.SelectedItem().(repoitem)
+			if ok {
+				m.confirm.open(&unstarAction, []repoitem{val})
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.list.nextList):
+			m.collections.next()
+			m.list.Title = m.title()
+			cmd := m.list.SetItems(m.getItems())
+			return m, cmd
+		case key.Matches(msg, m.keys.list.teamFilter):
+			if len(m.usernames) < 2 {
+				return m, nil
+			}
+			m.teamFilter = (m.teamFilter + 1) % 3
+			m.list.Title = m.title()
+			cmd := m.list.SetItems(m.getItems())
+			return m, cmd
+		case key.Matches(msg, m.keys.list.addTag):
+			val, ok := m.list.SelectedItem().(repoitem)
+			if !ok {
+				return m, nil
+			}
+			return m, m.prompt.open("Add tag", "", func(m *model, tag string) tea.Cmd {
+				return m.addTag([]repoitem{val}, tag)
+			})
+		case key.Matches(msg, m.keys.list.removeTag):
+			val, ok := m.list.SelectedItem().(repoitem)
+			if !ok {
+				return m, nil
+			}
+			return m, m.prompt.open("Remove tag", "", func(m *model, tag string) tea.Cmd {
+				return m.removeTag([]repoitem{val}, tag)
+			})
+		case key.Matches(msg, m.keys.list.editNote):
+			val, ok := m.list.SelectedItem().(repoitem)
+			if !ok {
+				return m, nil
+			}
+			return m, m.prompt.open("Note", val.note, func(m *model, note string) tea.Cmd {
+				return m.setNote([]repoitem{val}, note)
+			})
+		case key.Matches(msg, m.keys.sel.mark):
+			val, ok := m.list.SelectedItem().(repoitem)
+			if ok {
+				m.sel.toggle(val)
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.sel.visual):
+			if m.sel.visual {
+				m.sel.commitRange(m.list.VisibleItems(), m.list.Index())
+			} else {
+				m.sel.visual = true
+				m.sel.anchor = m.list.Index()
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.sel.markAll):
+			m.sel.toggleAll(m.list.VisibleItems())
+			return m, nil
+		case key.Matches(msg, m.keys.sel.clearMark):
+			m.sel.clear()
+			return m, nil
+		case key.Matches(msg, m.keys.sel.bulk):
+			items := m.markedItems()
+			if len(items) == 0 {
+				return m, m.status("Nothing marked")
+			}
+			m.actions.open(fmt.Sprintf("%d marked repos", len(items)), items, bulkActions)
+			return m, nil
+		case key.Matches(msg, m.keys.list.copyURL, m.keys.list.copyName, m.keys.list.copySSH, m.keys.list.copyMarkdown):
+			val, ok := m.list.SelectedItem().(repoitem)
+			if !ok {
+				return m, nil
+			}
+			var text string
+			switch {
+			case key.Matches(msg, m.keys.list.copyURL):
+				text = val.url
+			case key.Matches(msg, m.keys.list.copyName):
+				text = val.star.Repo.FullName
+			case key.Matches(msg, m.keys.list.copySSH):
+				text = val.star.Repo.SSHURL
+			case key.Matches(msg, m.keys.list.copyMarkdown):
+				text = markdownLink(val)
+			}
+			return m, copyCmd(text)
+		case key.Matches(msg, m.keys.list.open):
+			val, ok := m.list.SelectedItem().(repoitem)
+			if ok {
+				return m, openURLCmd(val.URL())
+			}
+			return m, nil
 		}
 	case tea.WindowSizeMsg:
-		h, v := docStyle.GetFrameSize()
-		m.list.SetSize(msg.Width-h, msg.Height-v)
+		m.width = msg.Width
+		m.height = msg.Height
+		m.resize()
 
 	case AddStarMsg:
-		// Skip archived repo (be an option in the future)
+		cmd := m.addStar(msg.star, msg.user)
+		return m, cmd
 
-		var upd string
-		last := monthsPassed(msg.star.Repo.UpdatedAt)
-		if last == 0 {
-			upd = "active"
-		} else {
-			upd = fmt.Sprintf("last %dm", last)
+	case ActionDoneMsg:
+		if msg.err != nil {
+			return m, m.statusError(msg.err)
+		}
+		return m, m.status(msg.status)
+
+	case UnstarredMsg:
+		cmd := m.removeItems(msg.ids)
+		status := fmt.Sprintf("Unstarred %d repos", len(msg.ids))
+		if msg.skipped > 0 {
+			status = fmt.Sprintf("%s, skipped %d not starred by you", status, msg.skipped)
+		}
+		if msg.err != nil {
+			status = fmt.Sprintf("%s, error: %s", status, msg.err)
 		}
-		if msg.star.Repo.Archived {
-			upd = "archived"
+		return m, tea.Batch(cmd, m.status(status))
+
+	case ListsMsg:
+		if msg.err != nil {
+			return m, m.statusError(fmt.Errorf("failed to load star lists: %w", msg.err))
 		}
-		desc := fmt.Sprintf("%s (%s; added %d; stars %d) \n %s",
-			langIcon.Lang(msg.star.Repo.Language),
-			upd,
-			monthsPassed(msg.star.StarredAt),
-			msg.star.Repo.StargazersCount,
-			msg.star.Repo.Description,
-		)
-		i := repoitem{
-			url:      msg.star.Repo.HTMLURL,
-			title:    msg.star.Repo.HTMLURL,
-			desc:     desc,
-			lang:     msg.star.Repo.Language,
-			tags:     msg.star.Repo.Topics,
-			archived: msg.star.Repo.Archived,
+		m.collections.set(msg.lists)
+		var status tea.Cmd
+		if m.initialList != "" && !m.collections.selectName(m.initialList) {
+			status = m.status(fmt.Sprintf("No star list %q", m.initialList))
 		}
-		m.items = append(m.items, i)
-		m.list.InsertItem(10000, i) // TODO use other value to add at the end of list
+		m.list.Title = m.title()
+		cmd := m.list.SetItems(m.getItems())
+		return m, tea.Batch(cmd, status)
+
+	case ReadmeMsg:
+		m.preview.store(msg)
 		return m, nil
 
 	case GhstarsStartMsg:
@@ -239,7 +612,10 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case GhstarsStopMsg:
-		m.list.StopSpinner()
+		m.fetching--
+		if m.fetching <= 0 {
+			m.list.StopSpinner()
+		}
 
 	// We handle errors just like any other message
 	case errMsg:
@@ -252,22 +628,127 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
-func (m model) View() string {
-	var blocks []string
+func (m model) updateFacets(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keys := m.facets.keys
+	switch {
+	case key.Matches(msg, keys.up):
+		m.facets.moveCursor(-1)
+		return m, nil
+	case key.Matches(msg, keys.down):
+		m.facets.moveCursor(1)
+		return m, nil
+	case key.Matches(msg, keys.leave):
+		m.facets.focused = false
+		if len(m.facets.selected) == 0 {
+			m.facets.visible = false
+		}
+		m.resize()
+		return m, nil
+	case key.Matches(msg, keys.toggle):
+		entries := m.facets.entries()
+		if m.facets.cursor < len(entries) {
+			m.facets.toggle(entries[m.facets.cursor].facet)
+		}
+	case key.Matches(msg, keys.mode):
+		if m.facets.mode == facetOr {
+			m.facets.mode = facetAnd
+		} else {
+			m.facets.mode = facetOr
+		}
+	case key.Matches(msg, keys.clear):
+		m.facets.selected = nil
+	default:
+		return m, nil
+	}
+
+	m.resize()
+	cmd := m.list.SetItems(m.getItems())
+	return m, cmd
+}
+
+func (m model) updateConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.confirm.keys.yes):
+		m.confirm.visible = false
+		cmd := m.confirm.action.run(&m, m.confirm.items)
+		return m, cmd
+	case key.Matches(msg, m.confirm.keys.no):
+		m.confirm.visible = false
+	}
+	return m, nil
+}
+
+func (m model) updatePrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.dialog.submit):
+		m.prompt.close()
+		cmd := m.prompt.onSubmit(&m, strings.TrimSpace(m.prompt.input.Value()))
+		return m, cmd
+	case key.Matches(msg, m.keys.dialog.cancel):
+		m.prompt.close()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.prompt.input, cmd = m.prompt.input.Update(msg)
+	return m, cmd
+}
 
-	// value := m.textInput.Value()
+func (m model) updatePreview(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.preview.keys.close):
+		m.preview.visible = false
+		m.resize()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.preview.viewport, cmd = m.preview.viewport.Update(msg)
+	return m, cmd
+}
 
-	// blocks = append(blocks, docStyle.Render(m.list.View()))
-	// blocks = append(blocks, m.textInput.View())
-	blocks = append(blocks, m.list.View())
-	val, ok := m.list.SelectedItem().(repoitem)
-	if ok {
-		blocks = append(blocks, docStyle.Render(fmt.Sprintf("select: %s", val.url)))
+func (m model) View() string {
+	switch {
+	case m.prompt.visible:
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.prompt.View())
+	case m.confirm.visible:
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.confirm.View())
+	case m.actions.visible:
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.actions.View())
 	}
 
-	return m.list.View()
+	view := m.list.View()
+	if chips := m.facets.chips(); chips != "" {
+		view = lipgloss.JoinVertical(lipgloss.Left, chips, view)
+	}
+	if m.facets.visible {
+		view = lipgloss.JoinHorizontal(lipgloss.Top, m.facets.View(), view)
+	}
+	if m.preview.visible {
+		view = lipgloss.JoinHorizontal(lipgloss.Top, view, m.preview.View())
+	} else if m.showDetail() && m.detailWidth > 0 {
+		var item *repoitem
+		if val, ok := m.list.SelectedItem().(repoitem); ok {
+			item = &val
+		}
+		view = lipgloss.JoinHorizontal(lipgloss.Top, view, renderDetail(item, m.detailWidth, m.height))
+	}
+	return view
+}
 
-	return lipgloss.JoinVertical(lipgloss.Left, blocks...)
+func newGithub(command string) *github.Github {
+	token, err := readToken(conf.Token)
+	if err != nil {
+		log.Fatalf("Error reading GitHub token: %s", err)
+	}
+	gh := github.New(token)
+	if conf.APIURL != "" {
+		gh.SetAPIURL(conf.APIURL)
+	}
+	gh.UseCache(useCache)
+	gh.SetCacheTTL(cacheTTL)
+	gh.SetJournal(journal.Open(journalFile, command))
+	return gh
 }
 
 func parseCLI() *cobra.Command {
@@ -275,21 +756,78 @@ func parseCLI() *cobra.Command {
 		Use:   "ghstars",
 		Short: "ghstars fetches and displays GitHub stars for a user",
 		Long:  `ghstars is a CLI application that fetches and displays the GitHub stars for a specified user`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			return applyConfig(cmd, cfg)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			m := initialModel(username)
+			users, err := loadUsers()
+			if err != nil {
+				return err
+			}
+			iconSet, err = icons.Get(iconName)
+			if err != nil {
+				return err
+			}
+			t, err := theme.Get(themeName, conf.Themes)
+			if err != nil {
+				return err
+			}
+			applyTheme(t)
+			order, err := parseSort(sortName)
+			if err != nil {
+				return err
+			}
+			keys, err := loadKeyMap(keymapName, conf.Keys)
+			if err != nil {
+				return err
+			}
+			gh := newGithub(cmd.Name())
+
+			notes, err := annotations.Load(annotationsFile)
+			if err != nil {
+				return err
+			}
+
+			m := initialModel(gh, notes, users, listName, keys)
+			m.sort = order
+			m.applyFilters(conf.Filters)
 			p := tea.NewProgram(m, tea.WithAltScreen())
 
-			go Ghfetch(p, username, useCache)
+			for _, user := range users {
+				go Ghfetch(p, gh, user)
+			}
 
-			_, err := p.Run()
+			_, err = p.Run()
 			return err
 		},
 	}
 
-	rootCmd.Flags().StringVarP(&username, "username", "u", "", "GitHub username to fetch stars for")
-	rootCmd.MarkFlagRequired("username")
-
-	rootCmd.Flags().BoolVarP(&useCache, "cache", "c", false, "Use cached data instead of fetching new data")
+	rootCmd.PersistentFlags().StringSliceVarP(&usernames, "username", "u", nil, "GitHub username to fetch stars for, several can be given")
+	rootCmd.PersistentFlags().StringVar(&teamFile, "team", "", "File with GitHub usernames, one per line")
+	rootCmd.PersistentFlags().BoolVarP(&useCache, "cache", "c", false, "Use cached data instead of fetching new data")
+
+	rootCmd.Flags().StringVarP(&listName, "list", "l", "", "Show only stars from GitHub star list")
+	rootCmd.Flags().StringVar(&themeName, "theme", "", "Color theme: "+strings.Join(theme.Names(), "|")+" or user theme from config, auto by default")
+	rootCmd.Flags().StringVar(&sortName, "sort", string(sortStarred), "Sort repos by: starred|stars|pushed|name")
+	rootCmd.Flags().StringVar(&keymapName, "keymap", "", "Key bindings preset: "+strings.Join(keyPresetNames(), "|"))
+	rootCmd.Flags().StringVar(&iconName, "icons", "nerd", "Icon set for languages, licenses, topics and repo states, states without icon show as text: "+strings.Join(icons.Names(), "|"))
+
+	rootCmd.AddCommand(starCommand())
+	rootCmd.AddCommand(unstarCommand())
+	rootCmd.AddCommand(pruneCommand())
+	rootCmd.AddCommand(historyCommand())
+	rootCmd.AddCommand(undoCommand())
+	rootCmd.AddCommand(tagCommand())
+	rootCmd.AddCommand(listsCommand())
+	rootCmd.AddCommand(orgCommand())
+	rootCmd.AddCommand(snapshotCommand())
+	rootCmd.AddCommand(diffCommand())
+	rootCmd.AddCommand(statsCommand())
+	rootCmd.AddCommand(configCommand())
 
 	return rootCmd
 }
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/github"
+	"github.com/tmshv/ghstars/set"
+)
+
+type orgRepo struct {
+	FullName string   `json:"full_name"`
+	URL      string   `json:"url"`
+	Language string   `json:"language"`
+	Stars    int      `json:"stars"`
+	Members  []string `json:"members"`
+
+	// Pages shift while fetching, so a star may be listed twice
+	members *set.SyncSet[string]
+}
+
+// aggregateStars fetches stars of every user with limited concurrency
+// and groups them by repo ranked by number of users starred it
+func aggregateStars(gh *github.Github, users []string, workers int) ([]*orgRepo, error) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	repos := map[int]*orgRepo{}
+
+	queue := make(chan string)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for user := range queue {
+				for res := range gh.GetStars(user) {
+					star, err := res.Unwrap()
+					mu.Lock()
+					if err != nil {
+						if firstErr == nil {
+							firstErr = fmt.Errorf("stars of %s: %w", user, err)
+						}
+						mu.Unlock()
+						break
+					}
+					repo, ok := repos[star.Repo.ID]
+					if !ok {
+						repo = &orgRepo{
+							FullName: star.Repo.FullName,
+							URL:      star.Repo.HTMLURL,
+							Language: star.Repo.Language,
+							Stars:    star.Repo.StargazersCount,
+							members:  set.NewSync[string](),
+						}
+						repos[star.Repo.ID] = repo
+					}
+					mu.Unlock()
+					repo.members.Add(user)
+				}
+			}
+		}()
+	}
+	for _, user := range users {
+		queue <- user
+	}
+	close(queue)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	ranked := make([]*orgRepo, 0, len(repos))
+	for _, repo := range repos {
+		repo.Members = set.Sorted(repo.members.Snapshot())
+		ranked = append(ranked, repo)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if len(a.Members) != len(b.Members) {
+			return len(a.Members) > len(b.Members)
+		}
+		if a.Stars != b.Stars {
+			return a.Stars > b.Stars
+		}
+		return a.FullName < b.FullName
+	})
+	return ranked, nil
+}
+
+func orgCommand() *cobra.Command {
+	var top, workers int
+	var export string
+	cmd := &cobra.Command{
+		Use:   "org <org>",
+		Short: "Rank repos starred by public members of organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gh := newGithub(cmd.Name())
+			members, err := gh.GetOrgMembers(args[0])
+			if err != nil {
+				return err
+			}
+			if len(members) == 0 {
+				return fmt.Errorf("%s has no public members", args[0])
+			}
+			fmt.Printf("Aggregating stars of %d members of %s\n\n", len(members), args[0])
+
+			repos, err := aggregateStars(gh, members, max(workers, 1))
+			if err != nil {
+				return err
+			}
+
+			if export != "" {
+				data, err := json.MarshalIndent(repos, "", "  ")
+				if err != nil {
+					return err
+				}
+				err = os.WriteFile(export, data, 0644)
+				if err != nil {
+					return err
+				}
+			}
+
+			shown := repos
+			if top > 0 && len(shown) > top {
+				shown = shown[:top]
+			}
+			fmt.Printf("%4s  %7s  %7s  %-40s  %s\n", "#", "MEMBERS", "STARS", "REPO", "LANGUAGE")
+			for i, repo := range shown {
+				fmt.Printf("%4d  %7d  %7d  %-40s  %s\n", i+1, len(repo.Members), repo.Stars, repo.FullName, repo.Language)
+			}
+
+			// Language breakdown counts member stars, not distinct repos
+			languages := set.NewCounter[string]()
+			for _, repo := range repos {
+				if repo.Language != "" {
+					languages.AddN(repo.Language, len(repo.Members))
+				}
+			}
+			fmt.Println("\nLanguages:")
+			for _, pair := range languages.MostCommon(top) {
+				fmt.Printf("%s: %d\n", pair.Value, pair.Count)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().IntVar(&top, "top", 50, "Number of repos and languages to show, 0 shows all")
+	cmd.Flags().IntVar(&workers, "workers", 4, "Number of members fetched at once")
+	cmd.Flags().StringVarP(&export, "export", "o", "", "Write full ranking as JSON to file")
+	return cmd
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tmshv/ghstars/github"
+)
+
+func TestAggregateStars(t *testing.T) {
+	pages := map[string][]string{
+		// Second page repeats star shifted by a new one
+		"/users/ann/starred": {
+			`[{"repo": {"id": 1, "full_name": "a/a", "stargazers_count": 5}}]`,
+			`[{"repo": {"id": 1, "full_name": "a/a", "stargazers_count": 5}}, {"repo": {"id": 2, "full_name": "b/b", "stargazers_count": 9}}]`,
+		},
+		"/users/bob/starred": {
+			`[{"repo": {"id": 1, "full_name": "a/a", "stargazers_count": 5}}]`,
+		},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := int(r.URL.Query().Get("page")[0] - '1')
+		if page < len(pages[r.URL.Path]) {
+			w.Write([]byte(pages[r.URL.Path][page]))
+			return
+		}
+		w.Write([]byte("[]"))
+	}))
+	defer srv.Close()
+	gh := github.New("token")
+	gh.SetAPIURL(srv.URL)
+
+	repos, err := aggregateStars(gh, []string{"bob", "ann"}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"a/a ann,bob", "b/b ann"}
+	var result []string
+	for _, repo := range repos {
+		result = append(result, repo.FullName+" "+strings.Join(repo.Members, ","))
+	}
+	if !slices.Equal(result, expected) {
+		t.Errorf("aggregateStars() = %v, want %v", result, expected)
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/tmshv/ghstars/github"
+)
+
+type ReadmeMsg struct {
+	id      int
+	content string
+	err     error
+}
+
+func GhFetchReadme(gh *github.Github, star *github.GhStarV3) tea.Cmd {
+	return func() tea.Msg {
+		content, err := gh.GetReadme(star.Repo.Owner.Login, star.Repo.Name)
+		return ReadmeMsg{id: star.Repo.ID, content: content, err: err}
+	}
+}
+
+type previewKeyMap struct {
+	close key.Binding
+}
+
+func newPreviewKeyMap() *previewKeyMap {
+	return &previewKeyMap{
+		close: key.NewBinding(
+			key.WithKeys("esc", "p"),
+			key.WithHelp("esc", "close preview"),
+		),
+	}
+}
+
+// preview shows README of a single repo rendered as markdown.
+// READMEs are fetched lazily and kept in memory by repo ID.
+type preview struct {
+	visible  bool
+	id       int
+	readmes  map[int]string
+	viewport viewport.Model
+	// Error of README being shown, not cached
+	err  error
+	keys *previewKeyMap
+}
+
+func newPreview(keys *previewKeyMap) preview {
+	return preview{
+		readmes:  map[int]string{},
+		viewport: viewport.New(0, 0),
+		keys:     keys,
+	}
+}
+
+// open shows README of the star and returns command to fetch it if needed
+func (p *preview) open(gh *github.Github, star *github.GhStarV3) tea.Cmd {
+	p.visible = true
+	p.id = star.Repo.ID
+	p.err = nil
+	if _, ok := p.readmes[p.id]; ok {
+		p.render()
+		return nil
+	}
+	p.viewport.SetContent(fmt.Sprintf("Loading README of %s…", star.Repo.FullName))
+	return GhFetchReadme(gh, star)
+}
+
+// store keeps fetched README, errors are only shown so reopening retries
+func (p *preview) store(msg ReadmeMsg) {
+	if msg.err == nil {
+		p.readmes[msg.id] = msg.content
+	}
+	if msg.id == p.id {
+		p.err = msg.err
+		p.render()
+	}
+}
+
+func (p *preview) setSize(width, height int) {
+	p.viewport.Width = width - previewStyle.GetHorizontalFrameSize()
+	p.viewport.Height = height
+	if p.visible {
+		p.render()
+	}
+}
+
+func (p *preview) render() {
+	if p.err != nil {
+		p.viewport.SetContent(fmt.Sprintf("Failed to load README: %s", p.err))
+		return
+	}
+	content := p.readmes[p.id]
+	r, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(currentTheme.Markdown),
+		glamour.WithWordWrap(p.viewport.Width),
+	)
+	if err == nil {
+		if out, err := r.Render(content); err == nil {
+			content = out
+		}
+	}
+	p.viewport.SetContent(content)
+	p.viewport.GotoTop()
+}
+
+func (p *preview) View() string {
+	return previewStyle.Render(p.viewport.View())
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/github"
+	"github.com/tmshv/ghstars/journal"
+	"github.com/tmshv/ghstars/set"
+)
+
+const pruneKeepFile = ".gh_prune_keep.json"
+
+func loadPruneKeep() (*set.Set[string], error) {
+	keep := set.New[string]()
+	data, err := os.ReadFile(pruneKeepFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return keep, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, keep)
+	if err != nil {
+		return nil, err
+	}
+	return keep, nil
+}
+
+func savePruneKeep(keep *set.Set[string]) error {
+	data, err := json.MarshalIndent(set.Sorted(keep), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(pruneKeepFile, data, 0644)
+}
+
+// undoPrune stars back repos unstarred by the last prune session
+func undoPrune(gh *github.Github) error {
+	entries, err := journal.Open(journalFile, "prune").Entries()
+	if err != nil {
+		return err
+	}
+
+	var session string
+	var last []journal.Entry
+	for _, e := range journal.Pending(entries) {
+		if e.Command != "prune" {
+			continue
+		}
+		if e.Session != session {
+			session = e.Session
+			last = nil
+		}
+		last = append(last, e)
+	}
+	return undoEntries(gh, last)
+}
+
+// pruneCandidates returns stars flagged by query except kept ones
+// of the authenticated user, prune unstars them
+func pruneCandidates(gh *github.Github, query starQuery, keep *set.Set[string]) ([]*github.GhStarV3, error) {
+	var candidates []*github.GhStarV3
+	for res := range gh.GetOwnStars() {
+		star, err := res.Unwrap()
+		if err != nil {
+			return nil, err
+		}
+		if keep.Has(star.Repo.FullName) {
+			continue
+		}
+		if len(query.reasons(star)) > 0 {
+			candidates = append(candidates, star)
+		}
+	}
+	return candidates, nil
+}
+
+func pruneCommand() *cobra.Command {
+	var undo bool
+	var query starQuery
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Walk through archived and abandoned stars and unstar them",
+		Long: `prune flags stars matching any of the given criteria and asks what to do with each of them.
+Without criteria archived, disabled and not pushed for 24 months repos are flagged.
+Unstarred repos are journaled, so the last session can be reverted with --undo.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			gh := newGithub(cmd.Name())
+			if undo {
+				return undoPrune(gh)
+			}
+			if query.empty() {
+				query = starQuery{archived: true, disabled: true, inactive: 24}
+			}
+
+			keep, err := loadPruneKeep()
+			if err != nil {
+				return err
+			}
+
+			// Kept repos are saved even if unstar fails
+			defer func() {
+				err = cmp.Or(err, savePruneKeep(keep))
+			}()
+
+			candidates, err := pruneCandidates(gh, query, keep)
+			if err != nil {
+				return err
+			}
+			if len(candidates) == 0 {
+				fmt.Println("nothing to prune")
+				return nil
+			}
+
+			reader := bufio.NewReader(os.Stdin)
+			var unstarred int
+		loop:
+			for i, star := range candidates {
+				fmt.Printf("\n[%d/%d] %s\n", i+1, len(candidates), star.Repo.FullName)
+				if star.Repo.Description != "" {
+					fmt.Printf("  %s\n", star.Repo.Description)
+				}
+				fmt.Printf("  flagged: %s\n", strings.Join(query.reasons(star), ", "))
+				fmt.Print("  [k]eep, [u]nstar, [s]kip, [q]uit? ")
+
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					break
+				}
+				switch strings.TrimSpace(strings.ToLower(line)) {
+				case "k", "keep":
+					keep.Add(star.Repo.FullName)
+				case "u", "unstar":
+					owner, repo := repoName(star)
+					err := gh.Unstar(owner, repo)
+					if err != nil {
+						return err
+					}
+					unstarred++
+				case "q", "quit":
+					break loop
+				}
+			}
+
+			fmt.Printf("\nunstarred %d repos\n", unstarred)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&undo, "undo", false, "Star back repos unstarred by the last prune")
+	query.bind(cmd.Flags())
+	return cmd
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tmshv/ghstars/github"
+	"github.com/tmshv/ghstars/set"
+)
+
+func TestPruneCandidates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/starred" {
+			t.Errorf("requested %s, want own stars", r.URL.Path)
+		}
+		if r.URL.Query().Get("page") != "1" {
+			w.Write([]byte("[]"))
+			return
+		}
+		w.Write([]byte(`[
+			{"repo": {"id": 1, "full_name": "a/old", "archived": true}},
+			{"repo": {"id": 2, "full_name": "b/live"}},
+			{"repo": {"id": 3, "full_name": "c/kept", "archived": true}},
+			{"repo": {"id": 4, "full_name": "d/gone", "archived": true}},
+			{"repo": {"id": 5, "full_name": "e/live"}}
+		]`))
+	}))
+	defer srv.Close()
+	gh := github.New("token")
+	gh.SetAPIURL(srv.URL)
+
+	candidates, err := pruneCandidates(gh, starQuery{archived: true}, set.Of("c/kept"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"a/old", "d/gone"}
+	if len(candidates) != len(expected) {
+		t.Fatalf("got %d candidates, want %d", len(candidates), len(expected))
+	}
+	for i, name := range expected {
+		if candidates[i].Repo.FullName != name {
+			t.Errorf("candidates[%d] = %s, want %s", i, candidates[i].Repo.FullName, name)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+	"github.com/tmshv/ghstars/github"
+)
+
+// starQuery selects stars by repo state. Each set criterion
+// gives a reason, a star matches when all of them are given.
+type starQuery struct {
+	archived bool
+	disabled bool
+	forks    bool
+	language string
+	topic    string
+	inactive int
+}
+
+func (q *starQuery) bind(flags *pflag.FlagSet) {
+	flags.BoolVar(&q.archived, "archived", false, "Select archived repos")
+	flags.BoolVar(&q.disabled, "disabled", false, "Select disabled repos")
+	flags.BoolVar(&q.forks, "forks", false, "Select forks")
+	flags.StringVar(&q.language, "language", "", "Select repos written in language")
+	flags.StringVar(&q.topic, "topic", "", "Select repos with topic")
+	flags.IntVar(&q.inactive, "inactive", 0, "Select repos not pushed for given number of months")
+}
+
+func (q *starQuery) criteria() int {
+	var n int
+	for _, set := range []bool{q.archived, q.disabled, q.forks, q.language != "", q.topic != "", q.inactive > 0} {
+		if set {
+			n++
+		}
+	}
+	return n
+}
+
+func (q *starQuery) empty() bool {
+	return q.criteria() == 0
+}
+
+func (q *starQuery) reasons(star *github.GhStarV3) []string {
+	var reasons []string
+	repo := star.Repo
+	if q.archived && repo.Archived {
+		reasons = append(reasons, "archived")
+	}
+	if q.disabled && repo.Disabled {
+		reasons = append(reasons, "disabled")
+	}
+	if q.forks && repo.Fork {
+		reasons = append(reasons, "fork")
+	}
+	if q.language != "" && repo.Language == q.language {
+		reasons = append(reasons, fmt.Sprintf("language %s", repo.Language))
+	}
+	if q.topic != "" && slices.Contains(repo.Topics, q.topic) {
+		reasons = append(reasons, fmt.Sprintf("topic %s", q.topic))
+	}
+	if q.inactive > 0 {
+		if last := monthsPassed(repo.PushedAt); last >= q.inactive {
+			reasons = append(reasons, fmt.Sprintf("no push for %d months", last))
+		}
+	}
+	return reasons
+}
+
+func (q *starQuery) match(star *github.GhStarV3) bool {
+	return len(q.reasons(star)) == q.criteria()
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/tmshv/ghstars/set"
+)
+
+// selection holds repos marked for bulk actions.
+// It is shared by pointer between model and list delegate.
+type selection struct {
+	marked *set.Set[int]
+	visual bool
+	anchor int
+}
+
+func newSelection() *selection {
+	return &selection{marked: set.New[int]()}
+}
+
+func (s *selection) inRange(index, cursor int) bool {
+	if !s.visual {
+		return false
+	}
+	return index >= min(s.anchor, cursor) && index <= max(s.anchor, cursor)
+}
+
+func (s *selection) isMarked(item repoitem, index, cursor int) bool {
+	return s.marked.Has(item.star.Repo.ID) || s.inRange(index, cursor)
+}
+
+func (s *selection) toggle(item repoitem) {
+	id := item.star.Repo.ID
+	if s.marked.Has(id) {
+		s.marked.Del(id)
+	} else {
+		s.marked.Add(id)
+	}
+}
+
+// commitRange marks every item of the visual range and leaves visual mode
+func (s *selection) commitRange(items []list.Item, cursor int) {
+	for i, item := range items {
+		if val, ok := item.(repoitem); ok && s.inRange(i, cursor) {
+			s.marked.Add(val.star.Repo.ID)
+		}
+	}
+	s.visual = false
+}
+
+// toggleAll marks all items or clears marks when all of them are marked
+func (s *selection) toggleAll(items []list.Item) {
+	all := true
+	for _, item := range items {
+		if val, ok := item.(repoitem); ok && !s.marked.Has(val.star.Repo.ID) {
+			all = false
+			break
+		}
+	}
+	for _, item := range items {
+		if val, ok := item.(repoitem); ok {
+			if all {
+				s.marked.Del(val.star.Repo.ID)
+			} else {
+				s.marked.Add(val.star.Repo.ID)
+			}
+		}
+	}
+}
+
+func (s *selection) clear() {
+	s.marked = set.New[int]()
+	s.visual = false
+}
+
+type selectKeyMap struct {
+	mark      key.Binding
+	visual    key.Binding
+	markAll   key.Binding
+	clearMark key.Binding
+	bulk      key.Binding
+}
+
+func newSelectKeyMap() *selectKeyMap {
+	return &selectKeyMap{
+		mark: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "mark"),
+		),
+		visual: key.NewBinding(
+			key.WithKeys("V"),
+			key.WithHelp("V", "visual select"),
+		),
+		markAll: key.NewBinding(
+			key.WithKeys("ctrl+a"),
+			key.WithHelp("ctrl+a", "mark all"),
+		),
+		clearMark: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "clear marks"),
+		),
+		bulk: key.NewBinding(
+			key.WithKeys("B"),
+			key.WithHelp("B", "bulk actions"),
+		),
+	}
+}
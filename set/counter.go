@@ -0,0 +1,109 @@
+package set
+
+import (
+	"iter"
+	"slices"
+	"sort"
+)
+
+// Counter is a multiset counting occurrences of values.
+// Values with equal counts keep the order they were first added in.
+type Counter[T comparable] struct {
+	counts map[T]int
+	order  []T
+}
+
+type Pair[T comparable] struct {
+	Value T
+	Count int
+}
+
+func NewCounter[T comparable]() *Counter[T] {
+	return &Counter[T]{counts: make(map[T]int)}
+}
+
+func (c *Counter[T]) Add(value T) {
+	c.AddN(value, 1)
+}
+
+// AddN adds n occurrences of value, counts dropping to zero or below
+// remove value from counter
+func (c *Counter[T]) AddN(value T, n int) {
+	count, exists := c.counts[value]
+	count += n
+	switch {
+	case count <= 0 && exists:
+		delete(c.counts, value)
+		c.order = slices.DeleteFunc(c.order, func(v T) bool {
+			return v == value
+		})
+	case count > 0 && !exists:
+		c.counts[value] = count
+		c.order = append(c.order, value)
+	case count > 0:
+		c.counts[value] = count
+	}
+}
+
+func (c *Counter[T]) Count(value T) int {
+	return c.counts[value]
+}
+
+// Len returns number of distinct values
+func (c *Counter[T]) Len() int {
+	return len(c.counts)
+}
+
+// Total returns sum of all counts
+func (c *Counter[T]) Total() int {
+	var total int
+	for _, count := range c.counts {
+		total += count
+	}
+	return total
+}
+
+// All iterates over values and counts in order values were added
+func (c *Counter[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for _, value := range c.order {
+			if !yield(value, c.counts[value]) {
+				return
+			}
+		}
+	}
+}
+
+// MostCommon returns n values with highest counts, all of them when n <= 0
+func (c *Counter[T]) MostCommon(n int) []Pair[T] {
+	pairs := make([]Pair[T], 0, len(c.order))
+	for value, count := range c.All() {
+		pairs = append(pairs, Pair[T]{Value: value, Count: count})
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].Count > pairs[j].Count
+	})
+	if n > 0 && n < len(pairs) {
+		pairs = pairs[:n]
+	}
+	return pairs
+}
+
+// Merge adds counts of other
+func (c *Counter[T]) Merge(other *Counter[T]) {
+	for value, count := range other.All() {
+		c.AddN(value, count)
+	}
+}
+
+// Subtract removes counts of other, values left without occurrences are dropped
+func (c *Counter[T]) Subtract(other *Counter[T]) {
+	for value, count := range other.All() {
+		c.AddN(value, -count)
+	}
+}
+
+// Set returns distinct values of counter
+func (c *Counter[T]) Set() *Set[T] {
+	return Of(c.order...)
+}
@@ -0,0 +1,121 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func pairs(c *Counter[string]) []Pair[string] {
+	return c.MostCommon(0)
+}
+
+func counterOf(values ...string) *Counter[string] {
+	c := NewCounter[string]()
+	for _, value := range values {
+		c.Add(value)
+	}
+	return c
+}
+
+func TestCounterCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		value    string
+		expected int
+	}{
+		{"empty", nil, "go", 0},
+		{"single", []string{"go"}, "go", 1},
+		{"repeated", []string{"go", "rust", "go"}, "go", 2},
+		{"missing", []string{"go", "rust"}, "c", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := counterOf(tt.values...)
+			if got := c.Count(tt.value); got != tt.expected {
+				t.Errorf("Count(%q) = %d, want %d", tt.value, got, tt.expected)
+			}
+			if got := c.Total(); got != len(tt.values) {
+				t.Errorf("Total() = %d, want %d", got, len(tt.values))
+			}
+		})
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	values := []string{"go", "rust", "go", "c", "rust", "go", "zig"}
+	tests := []struct {
+		name     string
+		n        int
+		expected []Pair[string]
+	}{
+		{"all", 0, []Pair[string]{{"go", 3}, {"rust", 2}, {"c", 1}, {"zig", 1}}},
+		{"top", 2, []Pair[string]{{"go", 3}, {"rust", 2}}},
+		{"ties keep order", 3, []Pair[string]{{"go", 3}, {"rust", 2}, {"c", 1}}},
+		{"more than len", 10, []Pair[string]{{"go", 3}, {"rust", 2}, {"c", 1}, {"zig", 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := counterOf(values...).MostCommon(tt.n); !slices.Equal(got, tt.expected) {
+				t.Errorf("MostCommon(%d) = %v, want %v", tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMergeSubtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []string
+		merge    []Pair[string]
+		subtract []Pair[string]
+	}{
+		{
+			name:     "empty",
+			merge:    []Pair[string]{},
+			subtract: []Pair[string]{},
+		},
+		{
+			name:     "overlap",
+			a:        []string{"go", "go", "rust"},
+			b:        []string{"go", "c"},
+			merge:    []Pair[string]{{"go", 3}, {"rust", 1}, {"c", 1}},
+			subtract: []Pair[string]{{"go", 1}, {"rust", 1}},
+		},
+		{
+			name:     "subtract drops values",
+			a:        []string{"go"},
+			b:        []string{"go", "go"},
+			merge:    []Pair[string]{{"go", 3}},
+			subtract: []Pair[string]{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := counterOf(tt.a...)
+			c.Merge(counterOf(tt.b...))
+			if got := pairs(c); !slices.Equal(got, tt.merge) {
+				t.Errorf("Merge() = %v, want %v", got, tt.merge)
+			}
+
+			c = counterOf(tt.a...)
+			c.Subtract(counterOf(tt.b...))
+			if got := pairs(c); !slices.Equal(got, tt.subtract) {
+				t.Errorf("Subtract() = %v, want %v", got, tt.subtract)
+			}
+			if c.Len() != len(tt.subtract) {
+				t.Errorf("Len() = %d, want %d", c.Len(), len(tt.subtract))
+			}
+		})
+	}
+}
+
+func TestCounterSet(t *testing.T) {
+	c := counterOf("go", "rust", "go")
+	if got := Sorted(c.Set()); !slices.Equal(got, []string{"go", "rust"}) {
+		t.Errorf("Set() = %v, want [go rust]", got)
+	}
+}
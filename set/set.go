@@ -1,5 +1,13 @@
 package set
 
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"iter"
+	"slices"
+)
+
 type Set[T comparable] struct {
 	elements map[T]bool
 }
@@ -8,6 +16,15 @@ func New[T comparable]() *Set[T] {
 	return &Set[T]{elements: make(map[T]bool)}
 }
 
+// Of returns set holding values
+func Of[T comparable](values ...T) *Set[T] {
+	s := New[T]()
+	for _, value := range values {
+		s.Add(value)
+	}
+	return s
+}
+
 func (s *Set[T]) Add(value T) {
 	s.elements[value] = true
 }
@@ -21,6 +38,10 @@ func (s *Set[T]) Has(value T) bool {
 	return exists
 }
 
+func (s *Set[T]) Len() int {
+	return len(s.elements)
+}
+
 func (s *Set[T]) Items() []T {
 	items := make([]T, 0, len(s.elements))
 	for value := range s.elements {
@@ -28,3 +49,115 @@ func (s *Set[T]) Items() []T {
 	}
 	return items
 }
+
+// All iterates over values in unspecified order
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range s.elements {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns values of s in ascending order
+func Sorted[T cmp.Ordered](s *Set[T]) []T {
+	items := s.Items()
+	slices.Sort(items)
+	return items
+}
+
+func (s *Set[T]) Clone() *Set[T] {
+	res := New[T]()
+	for value := range s.elements {
+		res.Add(value)
+	}
+	return res
+}
+
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	res := s.Clone()
+	for value := range other.elements {
+		res.Add(value)
+	}
+	return res
+}
+
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	res := New[T]()
+	for value := range s.elements {
+		if other.Has(value) {
+			res.Add(value)
+		}
+	}
+	return res
+}
+
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	res := New[T]()
+	for value := range s.elements {
+		if !other.Has(value) {
+			res.Add(value)
+		}
+	}
+	return res
+}
+
+// SymmetricDifference returns values present in exactly one of the sets
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	res := s.Difference(other)
+	for value := range other.elements {
+		if !s.Has(value) {
+			res.Add(value)
+		}
+	}
+	return res
+}
+
+// IsSubset reports whether every value of s is in other
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for value := range s.elements {
+		if !other.Has(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// MarshalJSON encodes set as JSON array. Elements are sorted by their
+// encoding, so equal sets encode the same.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	items := make([]json.RawMessage, 0, len(s.elements))
+	for value := range s.elements {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, data)
+	}
+	slices.SortFunc(items, func(a, b json.RawMessage) int {
+		return bytes.Compare(a, b)
+	})
+	return json.Marshal(items)
+}
+
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	err := json.Unmarshal(data, &items)
+	if err != nil {
+		return err
+	}
+	s.elements = make(map[T]bool, len(items))
+	for _, value := range items {
+		s.Add(value)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package set
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestAlgebra(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      []int
+		union     []int
+		intersect []int
+		diff      []int
+		symdiff   []int
+	}{
+		{"empty", nil, nil, []int{}, []int{}, []int{}, []int{}},
+		{"disjoint", []int{1, 2}, []int{3}, []int{1, 2, 3}, []int{}, []int{1, 2}, []int{1, 2, 3}},
+		{"overlap", []int{1, 2, 3}, []int{2, 3, 4}, []int{1, 2, 3, 4}, []int{2, 3}, []int{1}, []int{1, 4}},
+		{"same", []int{1, 2}, []int{2, 1}, []int{1, 2}, []int{1, 2}, []int{}, []int{}},
+		{"one empty", []int{1}, nil, []int{1}, []int{}, []int{1}, []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := Of(tt.a...), Of(tt.b...)
+			check := func(op string, result *Set[int], expected []int) {
+				if got := Sorted(result); !slices.Equal(got, expected) {
+					t.Errorf("%s = %v, want %v", op, got, expected)
+				}
+			}
+			check("Union", a.Union(b), tt.union)
+			check("Intersect", a.Intersect(b), tt.intersect)
+			check("Difference", a.Difference(b), tt.diff)
+			check("SymmetricDifference", a.SymmetricDifference(b), tt.symdiff)
+
+			// Operands stay untouched
+			check("a", a, Sorted(Of(tt.a...)))
+			check("b", b, Sorted(Of(tt.b...)))
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   []string
+		equal  bool
+		subset bool
+	}{
+		{"empty", nil, nil, true, true},
+		{"equal", []string{"go", "rust"}, []string{"rust", "go"}, true, true},
+		{"subset", []string{"go"}, []string{"go", "rust"}, false, true},
+		{"superset", []string{"go", "rust"}, []string{"go"}, false, false},
+		{"same size", []string{"go"}, []string{"rust"}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := Of(tt.a...), Of(tt.b...)
+			if got := a.Equal(b); got != tt.equal {
+				t.Errorf("Equal() = %v, want %v", got, tt.equal)
+			}
+			if got := a.IsSubset(b); got != tt.subset {
+				t.Errorf("IsSubset() = %v, want %v", got, tt.subset)
+			}
+		})
+	}
+}
+
+func TestLenAndClone(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+	}{
+		{"empty", nil, 0},
+		{"unique", []int{1, 2, 3}, 3},
+		{"duplicates", []int{1, 1, 2}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Of(tt.values...)
+			if s.Len() != tt.expected {
+				t.Errorf("Len() = %d, want %d", s.Len(), tt.expected)
+			}
+			c := s.Clone()
+			if !c.Equal(s) {
+				t.Errorf("Clone() = %v, want %v", Sorted(c), Sorted(s))
+			}
+			c.Add(100)
+			if s.Has(100) {
+				t.Error("Clone() shares elements with original")
+			}
+		})
+	}
+}
+
+func TestAll(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+	}{
+		{"empty", nil},
+		{"values", []string{"c", "a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for value := range Of(tt.values...).All() {
+				got = append(got, value)
+			}
+			slices.Sort(got)
+			expected := slices.Clone(tt.values)
+			slices.Sort(expected)
+			if !slices.Equal(got, expected) {
+				t.Errorf("All() = %v, want %v", got, expected)
+			}
+		})
+	}
+
+	t.Run("break", func(t *testing.T) {
+		var n int
+		for range Of(1, 2, 3).All() {
+			n++
+			break
+		}
+		if n != 1 {
+			t.Errorf("iterated %d times after break, want 1", n)
+		}
+	})
+}
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		json   string
+	}{
+		{"empty", []string{}, `[]`},
+		{"values", []string{"cli", "go", "tui"}, `["cli","go","tui"]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := slices.Clone(tt.values)
+			slices.Reverse(values)
+			data, err := json.Marshal(Of(values...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.json {
+				t.Errorf("Marshal() = %s, want %s", data, tt.json)
+			}
+			var items []string
+			if err := json.Unmarshal(data, &items); err != nil {
+				t.Fatalf("Marshal() = %s, want JSON array: %v", data, err)
+			}
+
+			s := New[string]()
+			if err := json.Unmarshal(data, s); err != nil {
+				t.Fatal(err)
+			}
+			if got := Sorted(s); !slices.Equal(got, tt.values) {
+				t.Errorf("round trip = %v, want %v", got, tt.values)
+			}
+		})
+	}
+
+	t.Run("in struct", func(t *testing.T) {
+		var val struct {
+			Tags *Set[string] `json:"tags"`
+		}
+		if err := json.Unmarshal([]byte(`{"tags": ["b", "a", "b"]}`), &val); err != nil {
+			t.Fatal(err)
+		}
+		if got := Sorted(val.Tags); !slices.Equal(got, []string{"a", "b"}) {
+			t.Errorf("Tags = %v, want [a b]", got)
+		}
+	})
+
+	t.Run("bad input", func(t *testing.T) {
+		s := New[int]()
+		if err := json.Unmarshal([]byte(`{"a": 1}`), s); err == nil {
+			t.Error("Unmarshal() of object succeeded, want error")
+		}
+	})
+}
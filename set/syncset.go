@@ -0,0 +1,108 @@
+package set
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncSet is a Set safe for concurrent use by multiple goroutines
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set *Set[T]
+}
+
+func NewSync[T comparable]() *SyncSet[T] {
+	return &SyncSet[T]{set: New[T]()}
+}
+
+// SyncOf returns concurrent set holding values
+func SyncOf[T comparable](values ...T) *SyncSet[T] {
+	return &SyncSet[T]{set: Of(values...)}
+}
+
+func (s *SyncSet[T]) Add(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(value)
+}
+
+func (s *SyncSet[T]) Del(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Del(value)
+}
+
+func (s *SyncSet[T]) Has(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Has(value)
+}
+
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Len()
+}
+
+func (s *SyncSet[T]) Items() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Items()
+}
+
+// All iterates over copy of values so set may be changed while iterating
+func (s *SyncSet[T]) All() iter.Seq[T] {
+	return s.Snapshot().All()
+}
+
+// Snapshot returns plain copy of values
+func (s *SyncSet[T]) Snapshot() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+func (s *SyncSet[T]) Clone() *SyncSet[T] {
+	return &SyncSet[T]{set: s.Snapshot()}
+}
+
+// Binary operations copy other first, so two sets are never locked at once
+func (s *SyncSet[T]) Union(other *SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{set: s.Snapshot().Union(other.Snapshot())}
+}
+
+func (s *SyncSet[T]) Intersect(other *SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{set: s.Snapshot().Intersect(other.Snapshot())}
+}
+
+func (s *SyncSet[T]) Difference(other *SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{set: s.Snapshot().Difference(other.Snapshot())}
+}
+
+func (s *SyncSet[T]) SymmetricDifference(other *SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{set: s.Snapshot().SymmetricDifference(other.Snapshot())}
+}
+
+func (s *SyncSet[T]) IsSubset(other *SyncSet[T]) bool {
+	return s.Snapshot().IsSubset(other.Snapshot())
+}
+
+func (s *SyncSet[T]) Equal(other *SyncSet[T]) bool {
+	return s.Snapshot().Equal(other.Snapshot())
+}
+
+func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
+	return s.Snapshot().MarshalJSON()
+}
+
+func (s *SyncSet[T]) UnmarshalJSON(data []byte) error {
+	set := New[T]()
+	err := set.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set = set
+	return nil
+}
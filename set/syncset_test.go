@@ -0,0 +1,185 @@
+package set
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncSetConcurrent(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		values  int
+	}{
+		{"single worker", 1, 100},
+		{"many workers", 8, 1000},
+		{"overlapping workers", 16, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSync[int]()
+			var wg sync.WaitGroup
+			for w := 0; w < tt.workers; w++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < tt.values; i++ {
+						s.Add(i)
+						s.Has(i)
+						for range s.All() {
+							break
+						}
+					}
+					s.Len()
+				}()
+			}
+			wg.Wait()
+
+			if s.Len() != tt.values {
+				t.Errorf("Len() = %d, want %d", s.Len(), tt.values)
+			}
+		})
+	}
+}
+
+func TestSyncSetDelConcurrent(t *testing.T) {
+	s := SyncOf(1, 2, 3, 4, 5, 6, 7, 8)
+	var wg sync.WaitGroup
+	for _, value := range s.Items() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Del(value)
+		}()
+	}
+	wg.Wait()
+	if s.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", s.Len())
+	}
+}
+
+func TestSyncSetAlgebra(t *testing.T) {
+	a, b := SyncOf(1, 2, 3), SyncOf(2, 3, 4)
+	tests := []struct {
+		name     string
+		result   *SyncSet[int]
+		expected []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4}},
+		{"intersect", a.Intersect(b), []int{2, 3}},
+		{"difference", a.Difference(b), []int{1}},
+		{"symmetric difference", a.SymmetricDifference(b), []int{1, 4}},
+		{"clone", a.Clone(), []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sorted(tt.result.Snapshot()); !slices.Equal(got, tt.expected) {
+				t.Errorf("got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if !a.Intersect(b).IsSubset(a) || a.Equal(b) || !a.Equal(a.Clone()) {
+		t.Error("unexpected comparison result")
+	}
+}
+
+func TestSyncSetAlgebraConcurrent(t *testing.T) {
+	a, b := SyncOf(1, 2), SyncOf(2, 3)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			a.Union(b)
+			a.Add(i)
+		}()
+		go func() {
+			defer wg.Done()
+			b.Union(a)
+			b.Add(i)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSyncSetJSON(t *testing.T) {
+	data, err := json.Marshal(SyncOf(3, 1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSync[int]()
+	err = json.Unmarshal(data, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := Sorted(s.Snapshot()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+}
+
+func BenchmarkSetAdd(b *testing.B) {
+	s := New[int]()
+	for i := 0; i < b.N; i++ {
+		s.Add(i % 1024)
+	}
+}
+
+func BenchmarkSyncSetAdd(b *testing.B) {
+	s := NewSync[int]()
+	for i := 0; i < b.N; i++ {
+		s.Add(i % 1024)
+	}
+}
+
+func BenchmarkSetHas(b *testing.B) {
+	s := New[int]()
+	for i := 0; i < 1024; i++ {
+		s.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Has(i % 2048)
+	}
+}
+
+func BenchmarkSyncSetHas(b *testing.B) {
+	s := NewSync[int]()
+	for i := 0; i < 1024; i++ {
+		s.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Has(i % 2048)
+	}
+}
+
+func BenchmarkSyncSetHasParallel(b *testing.B) {
+	s := NewSync[int]()
+	for i := 0; i < 1024; i++ {
+		s.Add(i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			s.Has(i % 2048)
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncSetAddParallel(b *testing.B) {
+	s := NewSync[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			s.Add(i % 1024)
+			i++
+		}
+	})
+}
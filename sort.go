@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+type sortOrder string
+
+const (
+	// Order GitHub returns stars in, newest first
+	sortStarred sortOrder = "starred"
+	sortStars   sortOrder = "stars"
+	sortPushed  sortOrder = "pushed"
+	sortByName  sortOrder = "name"
+)
+
+var sortOrders = []sortOrder{sortStarred, sortStars, sortPushed, sortByName}
+
+func parseSort(val string) (sortOrder, error) {
+	if val == "" {
+		return sortStarred, nil
+	}
+	for _, o := range sortOrders {
+		if string(o) == val {
+			return o, nil
+		}
+	}
+	names := make([]string, len(sortOrders))
+	for i, o := range sortOrders {
+		names[i] = string(o)
+	}
+	return "", fmt.Errorf("unknown sort %q, expected one of %s", val, strings.Join(names, ", "))
+}
+
+// less reports whether a goes before b, starred order keeps items as fetched
+func (o sortOrder) less(a, b repoitem) bool {
+	x, y := a.star.Repo, b.star.Repo
+	switch o {
+	case sortStars:
+		return x.StargazersCount > y.StargazersCount
+	case sortPushed:
+		return x.PushedAt.After(y.PushedAt)
+	case sortByName:
+		return strings.ToLower(x.FullName) < strings.ToLower(y.FullName)
+	}
+	return false
+}
+
+// position returns index item is inserted at to keep items sorted
+func (o sortOrder) position(items []list.Item, item repoitem) int {
+	return sort.Search(len(items), func(i int) bool {
+		val, ok := items[i].(repoitem)
+		return ok && o.less(item, val)
+	})
+}
+
+func (o sortOrder) sort(items []list.Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, _ := items[i].(repoitem)
+		b, _ := items[j].(repoitem)
+		return o.less(a, b)
+	})
+}
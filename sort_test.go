@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/tmshv/ghstars/github"
+)
+
+func TestSortPosition(t *testing.T) {
+	item := func(name string, stars int) repoitem {
+		star := &github.GhStarV3{}
+		star.Repo.FullName = name
+		star.Repo.StargazersCount = stars
+		return repoitem{star: star}
+	}
+	items := []list.Item{item("b/b", 5), item("a/a", 30), item("c/c", 10)}
+
+	tests := []struct {
+		order    sortOrder
+		item     repoitem
+		expected int
+	}{
+		{sortStarred, item("d/d", 1), 3},
+		{sortStars, item("d/d", 20), 1},
+		{sortStars, item("d/d", 1), 3},
+		{sortByName, item("B/a", 0), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.order), func(t *testing.T) {
+			sorted := append([]list.Item(nil), items...)
+			tt.order.sort(sorted)
+			if got := tt.order.position(sorted, tt.item); got != tt.expected {
+				t.Errorf("position(%s) = %d, want %d", tt.item.star.Repo.FullName, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	if o, err := parseSort(""); err != nil || o != sortStarred {
+		t.Errorf("parseSort(\"\") = %q, %v, want starred", o, err)
+	}
+	if _, err := parseSort("forks"); err == nil {
+		t.Errorf("parseSort(\"forks\") expected error")
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/set"
+)
+
+func printCounter(title string, counter *set.Counter[string], top int) {
+	if counter.Len() == 0 {
+		return
+	}
+	fmt.Printf("\n%s (%d):\n", title, counter.Len())
+	for _, pair := range counter.MostCommon(top) {
+		fmt.Printf("%6d  %s\n", pair.Count, pair.Value)
+	}
+}
+
+func statsCommand() *cobra.Command {
+	var top int
+	cmd := &cobra.Command{
+		Use:   "stats [user|snapshot]",
+		Short: "Show most common languages, topics, licenses and owners of stars",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var arg string
+			if len(args) > 0 {
+				arg = args[0]
+			} else {
+				username, err := singleUser()
+				if err != nil {
+					return err
+				}
+				arg = username
+			}
+			src, err := loadStarSource(newGithub(cmd.Name()), arg)
+			if err != nil {
+				return err
+			}
+
+			languages := set.NewCounter[string]()
+			topics := set.NewCounter[string]()
+			licenses := set.NewCounter[string]()
+			owners := set.NewCounter[string]()
+			// Walk stars by repo ID so ties are ranked the same on every run
+			for _, id := range set.Sorted(src.ids()) {
+				repo := src.stars[id].Repo
+				if repo.Language != "" {
+					languages.Add(repo.Language)
+				}
+				for _, topic := range repo.Topics {
+					topics.Add(topic)
+				}
+				if repo.License.Name != "" {
+					licenses.Add(repo.License.Name)
+				}
+				owners.Add(repo.Owner.Login)
+			}
+
+			fmt.Printf("%s: %d stars\n", src.name, len(src.stars))
+			printCounter("Languages", languages, top)
+			printCounter("Topics", topics, top)
+			printCounter("Licenses", licenses, top)
+			printCounter("Owners", owners, top)
+			return nil
+		},
+	}
+	cmd.Flags().IntVar(&top, "top", 10, "Number of entries to show in each section, 0 shows all")
+	return cmd
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/tmshv/ghstars/theme"
+)
+
+// Styles of every pane, rebuilt by applyTheme
+var (
+	currentTheme theme.Theme
+
+	titleStyle       lipgloss.Style
+	docStyle         lipgloss.Style
+	statusStyle      lipgloss.Style
+	errorStyle       lipgloss.Style
+	inputStyle       lipgloss.Style
+	placeholderStyle lipgloss.Style
+
+	itemStyles list.DefaultItemStyles
+	helpStyles help.Styles
+	listTheme  list.Styles
+
+	detailStyle      lipgloss.Style
+	detailTitleStyle lipgloss.Style
+	detailLabelStyle lipgloss.Style
+
+	facetPanelStyle    lipgloss.Style
+	facetHeaderStyle   lipgloss.Style
+	facetCursorStyle   lipgloss.Style
+	facetSelectedStyle lipgloss.Style
+	facetChipStyle     lipgloss.Style
+
+	actionMenuStyle   lipgloss.Style
+	actionCursorStyle lipgloss.Style
+
+	previewStyle lipgloss.Style
+)
+
+func init() {
+	applyTheme(theme.Dark)
+}
+
+// applyTheme rebuilds styles of every pane from t,
+// with NO_COLOR set only text attributes are kept
+func applyTheme(t theme.Theme) {
+	if theme.NoColor() {
+		lipgloss.SetColorProfile(termenv.Ascii)
+		t.Markdown = "notty"
+	}
+	currentTheme = t
+	color := func(val string) lipgloss.Color {
+		return lipgloss.Color(val)
+	}
+	fg := func(val string) lipgloss.Style {
+		return lipgloss.NewStyle().Foreground(color(val))
+	}
+
+	titleStyle = lipgloss.NewStyle().
+		Foreground(color(t.OnColor)).
+		Background(color(t.Primary)).
+		Padding(0, 1)
+	docStyle = lipgloss.NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(color(t.Frame))
+	statusStyle = fg(t.Muted)
+	errorStyle = fg(t.Error)
+	inputStyle = lipgloss.NewStyle().
+		Foreground(color(t.OnColor)).
+		Background(color(t.Secondary))
+	placeholderStyle = inputStyle.Copy().Foreground(color(t.Muted))
+
+	itemStyles = list.NewDefaultItemStyles()
+	itemStyles.NormalTitle = itemStyles.NormalTitle.Foreground(color(t.Foreground))
+	itemStyles.NormalDesc = itemStyles.NormalDesc.Foreground(color(t.Muted))
+	itemStyles.SelectedTitle = itemStyles.SelectedTitle.Foreground(color(t.Accent)).BorderForeground(color(t.Accent))
+	itemStyles.SelectedDesc = itemStyles.SelectedDesc.Foreground(color(t.Accent)).BorderForeground(color(t.Accent))
+	itemStyles.DimmedTitle = itemStyles.DimmedTitle.Foreground(color(t.Dimmed))
+	itemStyles.DimmedDesc = itemStyles.DimmedDesc.Foreground(color(t.Dimmed))
+	itemStyles.FilterMatch = lipgloss.NewStyle().Underline(true).Foreground(color(t.Match))
+
+	listTheme = list.DefaultStyles()
+	listTheme.Title = titleStyle
+	listTheme.Spinner = fg(t.Muted)
+	listTheme.FilterPrompt = fg(t.Primary)
+	listTheme.FilterCursor = fg(t.Accent)
+	listTheme.DefaultFilterCharacterMatch = itemStyles.FilterMatch
+	listTheme.StatusBar = listTheme.StatusBar.Foreground(color(t.Muted))
+	listTheme.StatusEmpty = fg(t.Dimmed)
+	listTheme.StatusBarActiveFilter = fg(t.Foreground)
+	listTheme.StatusBarFilterCount = fg(t.Dimmed)
+	listTheme.NoItems = fg(t.Dimmed)
+	listTheme.ArabicPagination = fg(t.Dimmed)
+	listTheme.ActivePaginationDot = listTheme.ActivePaginationDot.Foreground(color(t.Foreground))
+	listTheme.InactivePaginationDot = listTheme.InactivePaginationDot.Foreground(color(t.Dimmed))
+	listTheme.DividerDot = listTheme.DividerDot.Foreground(color(t.Dimmed))
+
+	helpStyles = help.New().Styles
+	helpStyles.ShortKey = fg(t.Muted)
+	helpStyles.ShortDesc = fg(t.Dimmed)
+	helpStyles.ShortSeparator = fg(t.Dimmed)
+	helpStyles.FullKey = fg(t.Muted)
+	helpStyles.FullDesc = fg(t.Dimmed)
+	helpStyles.FullSeparator = fg(t.Dimmed)
+	helpStyles.Ellipsis = fg(t.Dimmed)
+
+	detailStyle = lipgloss.NewStyle().
+		PaddingLeft(1).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderLeft(true).
+		BorderForeground(color(t.Border))
+	detailTitleStyle = fg(t.Primary).Bold(true)
+	detailLabelStyle = fg(t.Muted).Width(14)
+
+	facetPanelStyle = lipgloss.NewStyle().
+		Width(facetPanelWidth).
+		PaddingLeft(1).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderRight(true).
+		BorderForeground(color(t.Border))
+	facetHeaderStyle = fg(t.Primary).Bold(true)
+	facetCursorStyle = fg(t.Accent)
+	facetSelectedStyle = fg(t.Foreground).Bold(true)
+	facetChipStyle = lipgloss.NewStyle().
+		Foreground(color(t.OnColor)).
+		Background(color(t.Secondary)).
+		Padding(0, 1).
+		MarginRight(1)
+
+	actionMenuStyle = lipgloss.NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(color(t.Frame)).
+		Padding(0, 1)
+	actionCursorStyle = fg(t.Accent)
+
+	previewStyle = lipgloss.NewStyle().
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderLeft(true).
+		BorderForeground(color(t.Border))
+}
+
+func (m *model) status(text string) tea.Cmd {
+	return m.list.NewStatusMessage(statusStyle.Render(text))
+}
+
+func (m *model) statusError(err error) tea.Cmd {
+	return m.list.NewStatusMessage(errorStyle.Render("Error: " + err.Error()))
+}
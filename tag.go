@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/annotations"
+)
+
+const annotationsFile = ".gh_annotations.json"
+
+// annotate resolves repo argument and applies fn to the annotation store
+func annotate(cmd *cobra.Command, arg string, fn func(store *annotations.Store, id int, repo string)) error {
+	owner, repo, err := parseRepo(arg)
+	if err != nil {
+		return err
+	}
+	store, err := annotations.Load(annotationsFile)
+	if err != nil {
+		return err
+	}
+	id, err := newGithub(cmd.Name()).RepoID(owner, repo)
+	if err != nil {
+		return err
+	}
+	fn(store, id, owner+"/"+repo)
+	return store.Save()
+}
+
+func tagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag",
+		Short: "Manage local tags and notes of starred repos",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "add owner/name tag...",
+		Short: "Add local tags to repo",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return annotate(cmd, args[0], func(store *annotations.Store, id int, repo string) {
+				for _, tag := range args[1:] {
+					store.AddTag(id, repo, tag)
+				}
+			})
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "rm owner/name tag...",
+		Short: "Remove local tags from repo",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return annotate(cmd, args[0], func(store *annotations.Store, id int, repo string) {
+				for _, tag := range args[1:] {
+					store.RemoveTag(id, tag)
+				}
+			})
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "note owner/name [text]",
+		Short: "Set note of repo, empty text removes it",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return annotate(cmd, args[0], func(store *annotations.Store, id int, repo string) {
+				store.SetNote(id, repo, strings.Join(args[1:], " "))
+			})
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "ls",
+		Short: "List annotated repos",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := annotations.Load(annotationsFile)
+			if err != nil {
+				return err
+			}
+			all := store.All()
+			items := make([]*annotations.Annotation, 0, len(all))
+			for _, a := range all {
+				items = append(items, a)
+			}
+			sort.Slice(items, func(i, j int) bool {
+				return items[i].Repo < items[j].Repo
+			})
+			for _, a := range items {
+				fmt.Printf("%s\t%s\t%s\n", a.Repo, strings.Join(a.Tags, ","), a.Note)
+			}
+			return nil
+		},
+	})
+
+	return cmd
+}
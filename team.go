@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/tmshv/ghstars/github"
+)
+
+// loadUsers returns logins given by --username and --team file.
+// Team file holds one login per line, # starts a comment.
+func loadUsers() ([]string, error) {
+	users := slices.Clone(usernames)
+	if teamFile != "" {
+		f, err := os.Open(teamFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line, _, _ := strings.Cut(scanner.Text(), "#")
+			if line = strings.TrimSpace(line); line != "" {
+				users = append(users, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	var unique []string
+	for _, user := range users {
+		if !slices.Contains(unique, user) {
+			unique = append(unique, user)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, fmt.Errorf("required flag \"username\" not set")
+	}
+	return unique, nil
+}
+
+// singleUser returns login for commands working with stars of one user
+func singleUser() (string, error) {
+	users, err := loadUsers()
+	if err != nil {
+		return "", err
+	}
+	if len(users) > 1 {
+		return "", fmt.Errorf("command works with a single user, got %d", len(users))
+	}
+	return users[0], nil
+}
+
+// teamFilter narrows team view by how many users starred a repo
+type teamFilter int
+
+const (
+	teamAll teamFilter = iota
+	teamEveryone
+	teamSingle
+)
+
+func (f teamFilter) String() string {
+	switch f {
+	case teamEveryone:
+		return "starred by everyone"
+	case teamSingle:
+		return "starred by one"
+	}
+	return "all"
+}
+
+func (f teamFilter) match(item repoitem, team int) bool {
+	switch f {
+	case teamEveryone:
+		return len(item.starredBy) == team
+	case teamSingle:
+		return len(item.starredBy) == 1
+	}
+	return true
+}
+
+func (m *model) title() string {
+	if len(m.usernames) == 1 {
+		return m.collections.title(m.usernames[0])
+	}
+	title := m.collections.title(fmt.Sprintf("Team of %d", len(m.usernames)))
+	if m.teamFilter != teamAll {
+		title += fmt.Sprintf(" (%s)", m.teamFilter)
+	}
+	return title
+}
+
+func (m *model) newItem(star *github.GhStarV3, starredBy []string) repoitem {
+	return newRepoItem(star, m.notes.Get(star.Repo.ID), starredBy)
+}
+
+// addStar merges star of user into items, repo starred by several
+// users is shown once with all of them
+func (m *model) addStar(star *github.GhStarV3, user string) tea.Cmd {
+	id := star.Repo.ID
+	idx, ok := m.index[id]
+	if !ok {
+		i := m.newItem(star, []string{user})
+		m.index[id] = len(m.items)
+		m.items = append(m.items, i)
+		m.facets.add(i)
+		if !m.keep(i) {
+			return nil
+		}
+		return m.list.InsertItem(m.sort.position(m.list.Items(), i), i)
+	}
+
+	prev := m.items[idx]
+	i := m.newItem(prev.star, append(slices.Clone(prev.starredBy), user))
+	m.items[idx] = i
+	for pos, item := range m.list.Items() {
+		if val, ok := item.(repoitem); ok && val.star.Repo.ID == id {
+			if m.keep(i) {
+				return m.list.SetItem(pos, i)
+			}
+			m.list.RemoveItem(pos)
+			return nil
+		}
+	}
+	if m.keep(i) {
+		return m.list.SetItems(m.getItems())
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package theme
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme is a palette every pane builds its styles from. Colors are
+// hex values or ANSI 256 numbers as accepted by lipgloss.Color.
+type Theme struct {
+	Name string `yaml:"-" toml:"-"`
+	// Base names built-in theme a user theme takes missing colors from,
+	// auto when empty
+	Base string `yaml:"base,omitempty" toml:"base,omitempty"`
+
+	Foreground string `yaml:"foreground,omitempty" toml:"foreground,omitempty"`
+	// Text drawn over Primary and Secondary backgrounds
+	OnColor   string `yaml:"on_color,omitempty" toml:"on_color,omitempty"`
+	Primary   string `yaml:"primary,omitempty" toml:"primary,omitempty"`
+	Secondary string `yaml:"secondary,omitempty" toml:"secondary,omitempty"`
+	Accent    string `yaml:"accent,omitempty" toml:"accent,omitempty"`
+	Muted     string `yaml:"muted,omitempty" toml:"muted,omitempty"`
+	Dimmed    string `yaml:"dimmed,omitempty" toml:"dimmed,omitempty"`
+	Match     string `yaml:"match,omitempty" toml:"match,omitempty"`
+	Border    string `yaml:"border,omitempty" toml:"border,omitempty"`
+	Frame     string `yaml:"frame,omitempty" toml:"frame,omitempty"`
+	Error     string `yaml:"error,omitempty" toml:"error,omitempty"`
+	// Glamour style used for READMEs: dark, light, notty...
+	Markdown string `yaml:"markdown,omitempty" toml:"markdown,omitempty"`
+}
+
+var Dark = Theme{
+	Name:       "dark",
+	Foreground: "#DDDDDD",
+	OnColor:    "#FFFDF5",
+	Primary:    "#25A065",
+	Secondary:  "#7D56F4",
+	Accent:     "#EE6FF8",
+	Muted:      "#BABABA",
+	Dimmed:     "#777777",
+	Match:      "#F25D94",
+	Border:     "240",
+	Frame:      "63",
+	Error:      "#FF5F87",
+	Markdown:   "dark",
+}
+
+var Light = Theme{
+	Name:       "light",
+	Foreground: "#1A1A1A",
+	OnColor:    "#FFFFFF",
+	Primary:    "#1A7F37",
+	Secondary:  "#6E40C9",
+	Accent:     "#AD58B4",
+	Muted:      "#57606A",
+	Dimmed:     "#A0A0A0",
+	Match:      "#CF222E",
+	Border:     "250",
+	Frame:      "62",
+	Error:      "#CF222E",
+	Markdown:   "light",
+}
+
+var HighContrast = Theme{
+	Name:       "high-contrast",
+	Foreground: "#FFFFFF",
+	OnColor:    "#000000",
+	Primary:    "#00FF00",
+	Secondary:  "#00FFFF",
+	Accent:     "#FFFF00",
+	Muted:      "#E0E0E0",
+	Dimmed:     "#A0A0A0",
+	Match:      "#FF00FF",
+	Border:     "#FFFFFF",
+	Frame:      "#FFFF00",
+	Error:      "#FF0000",
+	Markdown:   "dark",
+}
+
+var builtin = map[string]Theme{
+	Dark.Name:         Dark,
+	Light.Name:        Light,
+	HighContrast.Name: HighContrast,
+}
+
+// Names lists built-in themes, auto picks dark or light by terminal background
+func Names() []string {
+	return []string{"auto", Dark.Name, Light.Name, HighContrast.Name}
+}
+
+// NoColor reports whether user asked for no colors, see https://no-color.org
+func NoColor() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
+func auto() Theme {
+	if lipgloss.HasDarkBackground() {
+		return Dark
+	}
+	return Light
+}
+
+// inherit fills colors missing in t from base
+func (t Theme) inherit(base Theme) Theme {
+	fill := func(val *string, def string) {
+		if *val == "" {
+			*val = def
+		}
+	}
+	fill(&t.Foreground, base.Foreground)
+	fill(&t.OnColor, base.OnColor)
+	fill(&t.Primary, base.Primary)
+	fill(&t.Secondary, base.Secondary)
+	fill(&t.Accent, base.Accent)
+	fill(&t.Muted, base.Muted)
+	fill(&t.Dimmed, base.Dimmed)
+	fill(&t.Match, base.Match)
+	fill(&t.Border, base.Border)
+	fill(&t.Frame, base.Frame)
+	fill(&t.Error, base.Error)
+	fill(&t.Markdown, base.Markdown)
+	return t
+}
+
+func get(name string) (Theme, bool) {
+	if name == "" || name == "auto" {
+		return auto(), true
+	}
+	t, ok := builtin[name]
+	return t, ok
+}
+
+// Get returns built-in theme or one of user themes, which take
+// missing colors from their base theme
+func Get(name string, user map[string]Theme) (Theme, error) {
+	if t, ok := user[name]; ok {
+		base, ok := get(t.Base)
+		if !ok {
+			return Theme{}, fmt.Errorf("theme %s: unknown base theme %q", name, t.Base)
+		}
+		t.Name = name
+		return t.inherit(base), nil
+	}
+	if t, ok := get(name); ok {
+		return t, nil
+	}
+	names := Names()
+	for name := range user {
+		names = append(names, name)
+	}
+	slices.Sort(names[len(Names()):])
+	return Theme{}, fmt.Errorf("unknown theme %q, expected one of %s", name, strings.Join(names, ", "))
+}
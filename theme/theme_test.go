@@ -0,0 +1,56 @@
+package theme
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	user := map[string]Theme{
+		"solarized": {Base: "dark", Primary: "#268BD2", Accent: "#B58900"},
+		"paper":     {Base: "light", Markdown: "notty"},
+		"broken":    {Base: "missing"},
+		"dark":      {Base: "dark", Primary: "#000000"},
+	}
+	tests := []struct {
+		name     string
+		primary  string
+		accent   string
+		markdown string
+		err      string
+	}{
+		{"light", Light.Primary, Light.Accent, "light", ""},
+		{"high-contrast", HighContrast.Primary, HighContrast.Accent, "dark", ""},
+		{"solarized", "#268BD2", "#B58900", "dark", ""},
+		{"paper", Light.Primary, Light.Accent, "notty", ""},
+		{"dark", "#000000", Dark.Accent, "dark", ""},
+		{"broken", "", "", "", "unknown base theme"},
+		{"nope", "", "", "", "unknown theme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(tt.name, user)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Get(%q) error = %v, want %q", tt.name, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Name != tt.name || got.Primary != tt.primary || got.Accent != tt.accent || got.Markdown != tt.markdown {
+				t.Errorf("Get(%q) = %+v", tt.name, got)
+			}
+		})
+	}
+}
+
+func TestBuiltinComplete(t *testing.T) {
+	for name, th := range builtin {
+		if th.inherit(Theme{}) != th.inherit(Dark) {
+			t.Errorf("built-in theme %s misses colors", name)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tmshv/ghstars/github"
+)
+
+// parseRepo accepts owner/name or GitHub URL of repo
+func parseRepo(val string) (string, string, error) {
+	val = strings.TrimPrefix(val, "https://")
+	val = strings.TrimPrefix(val, "github.com/")
+	val = strings.TrimSuffix(val, "/")
+	parts := strings.Split(val, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("bad repo %q, expected owner/name", val)
+	}
+	return parts[0], parts[1], nil
+}
+
+func repoName(star *github.GhStarV3) (string, string) {
+	return star.Repo.Owner.Login, star.Repo.Name
+}
+
+func starCommand() *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "star owner/name...",
+		Short: "Star repositories",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gh := newGithub(cmd.Name())
+			for _, arg := range args {
+				owner, repo, err := parseRepo(arg)
+				if err != nil {
+					return err
+				}
+				if dryRun {
+					fmt.Printf("would star %s/%s\n", owner, repo)
+					continue
+				}
+				err = gh.Star(owner, repo)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("starred %s/%s\n", owner, repo)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Print what would change without changing it")
+	return cmd
+}
+
+func unstarCommand() *cobra.Command {
+	var dryRun bool
+	var query starQuery
+	cmd := &cobra.Command{
+		Use:   "unstar [owner/name...]",
+		Short: "Unstar repositories listed or matching a query",
+		Example: `  ghstars unstar tmshv/ghstars
+  ghstars unstar --archived --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && query.empty() {
+				return fmt.Errorf("pass repos or a query")
+			}
+			gh := newGithub(cmd.Name())
+
+			type target struct{ owner, repo string }
+			var targets []target
+			for _, arg := range args {
+				owner, repo, err := parseRepo(arg)
+				if err != nil {
+					return err
+				}
+				targets = append(targets, target{owner, repo})
+			}
+			if !query.empty() {
+				// Unstar acts on the authenticated user, so only own stars match
+				for res := range gh.GetOwnStars() {
+					star, err := res.Unwrap()
+					if err != nil {
+						return err
+					}
+					if query.match(star) {
+						owner, repo := repoName(star)
+						targets = append(targets, target{owner, repo})
+					}
+				}
+			}
+
+			for _, t := range targets {
+				if dryRun {
+					fmt.Printf("would unstar %s/%s\n", t.owner, t.repo)
+					continue
+				}
+				err := gh.Unstar(t.owner, t.repo)
+				if errors.Is(err, github.ErrNotStarred) {
+					fmt.Printf("not starred %s/%s\n", t.owner, t.repo)
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				fmt.Printf("unstarred %s/%s\n", t.owner, t.repo)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Print what would change without changing it")
+	query.bind(cmd.Flags())
+	return cmd
+}